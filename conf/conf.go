@@ -6,14 +6,18 @@ import (
 	"github.com/achwanyusuf/carrent-lib/pkg/redis"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain"
 	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest"
+	"github.com/achwanyusuf/carrent-ordersvc/src/handler/worker"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase"
 )
 
 type Config struct {
-	App     App            `mapstructure:"app"`
-	Rest    rest.Config    `mapstructure:"rest"`
-	Usecase usecase.Config `mapstructure:"usecase"`
-	Domain  domain.Config  `mapstructure:"domain"`
+	App        App            `mapstructure:"app"`
+	Rest       rest.Config    `mapstructure:"rest"`
+	Usecase    usecase.Config `mapstructure:"usecase"`
+	Domain     domain.Config  `mapstructure:"domain"`
+	Worker     worker.Config  `mapstructure:"worker"`
+	Permission policy.Config  `mapstructure:"permission"`
 }
 
 type GRPC struct {
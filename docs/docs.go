@@ -23,6 +23,122 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/audit": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get the insert, update and delete log of cars and orders, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "Get audit logs",
+                "parameters": [
+                    {
+                        "enum": [
+                            "car",
+                            "order"
+                        ],
+                        "type": "string",
+                        "description": "search by entity type",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by entity id",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "insert",
+                            "update",
+                            "delete"
+                        ],
+                        "type": "string",
+                        "description": "search by action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by actor",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by request id",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "rest",
+                            "grpc",
+                            "worker"
+                        ],
+                        "type": "string",
+                        "description": "search by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search from created at in RFC3339 format",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search until created at in RFC3339 format",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuditLogsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuditLogsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuditLogsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/car": {
             "get": {
                 "security": [
@@ -120,6 +236,13 @@ const docTemplate = `{
                         "name": "image",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "example": "-day_rate,id",
+                        "description": "comma separated columns, descending when prefixed by a minus",
+                        "name": "order_by",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": " ",
@@ -132,6 +255,22 @@ const docTemplate = `{
                         "name": "limit",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count",
+                        "name": "page_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, taken with the same order_by",
+                        "name": "cursor",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "must-revalidate",
@@ -190,6 +329,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/model.CreateCar"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key to safely retry the request, a repeated key returns the first result",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -214,6 +359,165 @@ const docTemplate = `{
                 }
             }
         },
+        "/car/available": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get cars without any order overlapping the pickup and dropoff date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "car"
+                ],
+                "summary": "Get available cars data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-02-02T00:00:00Z",
+                        "description": "pickup date",
+                        "name": "pickup_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-05T00:00:00Z",
+                        "description": "dropoff date",
+                        "name": "dropoff_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by car name",
+                        "name": "car_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by day rate",
+                        "name": "day_rate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by day rate greater than",
+                        "name": "day_rate_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by day rate greater than equal",
+                        "name": "day_rate_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by day rate less than",
+                        "name": "day_rate_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by day rate less than equal",
+                        "name": "day_rate_lte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by month rate",
+                        "name": "month_rate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by month rate greater than",
+                        "name": "month_rate_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by month rate greater than equal",
+                        "name": "month_rate_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by month rate less than",
+                        "name": "month_rate_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by month rate less than equal",
+                        "name": "month_rate_lte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-day_rate,id",
+                        "description": "comma separated columns, descending when prefixed by a minus",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count",
+                        "name": "page_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, taken with the same order_by",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CarsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.CarsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.CarsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/car/{id}": {
             "get": {
                 "security": [
@@ -256,6 +560,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.SingleCarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "car version"
+                            }
                         }
                     },
                     "400": {
@@ -305,6 +615,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/model.UpdateCar"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the car returned by get car",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -312,6 +629,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.SingleCarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "car version"
+                            }
                         }
                     },
                     "400": {
@@ -320,6 +643,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.SingleCarResponse"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleCarResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleCarResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -461,6 +796,187 @@ const docTemplate = `{
                         "name": "dropoff_long",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "orders renting on or after this date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orders renting on or before this date",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-01T00:00:00Z",
+                        "description": "search by order date on or after",
+                        "name": "order_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-29T00:00:00Z",
+                        "description": "search by order date on or before",
+                        "name": "order_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by pickup date on or after",
+                        "name": "pickup_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by pickup date on or before",
+                        "name": "pickup_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by dropoff date on or after",
+                        "name": "dropoff_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by dropoff date on or before",
+                        "name": "dropoff_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by creation time on or after",
+                        "name": "created_at_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by creation time on or before",
+                        "name": "created_at_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "search by any of car ids",
+                        "name": "car_id_in",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "confirmed",
+                                "picked_up",
+                                "returned",
+                                "completed",
+                                "cancelled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "search by any of statuses",
+                        "name": "status_in",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup within the radius of this lat",
+                        "name": "pickup_near_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup within the radius of this long",
+                        "name": "pickup_near_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "radius in km around the pickup near point",
+                        "name": "pickup_radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup inside the box from this lat",
+                        "name": "pickup_min_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup inside the box to this lat",
+                        "name": "pickup_max_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup inside the box from this long",
+                        "name": "pickup_min_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by pickup inside the box to this long",
+                        "name": "pickup_max_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff within the radius of this lat",
+                        "name": "dropoff_near_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff within the radius of this long",
+                        "name": "dropoff_near_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "radius in km around the dropoff near point",
+                        "name": "dropoff_radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff inside the box from this lat",
+                        "name": "dropoff_min_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff inside the box to this lat",
+                        "name": "dropoff_max_lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff inside the box from this long",
+                        "name": "dropoff_min_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "search by dropoff inside the box to this long",
+                        "name": "dropoff_max_long",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-pickup_date,car_id",
+                        "description": "comma separated columns, descending when prefixed by a minus, pickup_distance and dropoff_distance need the near filter",
+                        "name": "order_by",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": " ",
@@ -473,6 +989,38 @@ const docTemplate = `{
                         "name": "limit",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count",
+                        "name": "page_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, taken with the same order_by",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "car"
+                        ],
+                        "type": "string",
+                        "description": "embed the car of every order",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,status,car.car_name",
+                        "description": "comma separated fields every order is limited to, the fields of the car are prefixed by car",
+                        "name": "fields",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "must-revalidate",
@@ -531,6 +1079,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/model.CreateOrder"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key to safely retry the request, a repeated key returns the first result",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -555,14 +1109,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/order/{id}": {
+        "/order/overdue": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Get orders data",
+                "description": "Get picked up orders whose dropoff date has passed without a return",
                 "consumes": [
                     "application/json"
                 ],
@@ -572,14 +1126,57 @@ const docTemplate = `{
                 "tags": [
                     "order"
                 ],
-                "summary": "Get orders data",
+                "summary": "Get overdue orders data",
                 "parameters": [
                     {
+                        "type": "number",
+                        "description": "search by car id",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
                         "type": "string",
-                        "description": "get by id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count",
+                        "name": "page_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, taken with the same order_by",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "car"
+                        ],
+                        "type": "string",
+                        "description": "embed the car of every order",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,status,car.car_name",
+                        "description": "comma separated fields every order is limited to, the fields of the car are prefixed by car",
+                        "name": "fields",
+                        "in": "query"
                     },
                     {
                         "enum": [
@@ -596,30 +1193,882 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.OrdersResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.OrdersResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.OrdersResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/order/quote": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Preview order price without creating the order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Quote Order",
+                "parameters": [
+                    {
+                        "description": "Order Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuoteResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuoteResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuoteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/stream": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Push order change events as server-sent events, the event id is the one to send back in Last-Event-ID to resume after a reconnect. A comment line is sent as heartbeat while no event happens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Stream order events",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "search by car id",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orders renting on or after this date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orders renting on or before this date",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get orders data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get orders data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Update order data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Update order data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Order Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateOrder"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the order returned by get order",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Soft delete order data, restricted to super admin. Use cancel to cancel an order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Delete order data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "delete by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move pending or confirmed order to cancelled and charge the cancellation fee",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Cancel order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation Data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.CancelOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move returned order to completed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Complete order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move pending order to confirmed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Confirm order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/extend": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move the dropoff date of an active order forward when the car is still available",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Extend order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Extension Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ExtendOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderExtensionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderExtensionResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderExtensionResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderExtensionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get order status changes ordered from the oldest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get order status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderStatusHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderStatusHistoryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.OrderStatusHistoryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/pickup": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move confirmed order to picked up",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Pickup order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/return": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Move picked up order to returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Return order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleOrderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get webhooks created by the caller",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Get webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhooksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhooksResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhooksResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Subscribe an url to order events, deliveries are signed with the returned secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Create Webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleWebhookResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleWebhookResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Delete webhook created by the caller, its pending deliveries are not sent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "webhook id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}/delivery": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Update order data",
+                "description": "Get delivery log of a webhook created by the caller, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -627,55 +2076,77 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "order"
+                    "webhook"
                 ],
-                "summary": "Update order data",
+                "summary": "Get webhook deliveries",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update by id",
+                        "description": "webhook id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Order Data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateOrder"
-                        }
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "search by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.WebhookDeliveriesResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookDeliveriesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.SingleOrderResponse"
+                            "$ref": "#/definitions/model.WebhookDeliveriesResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/webhook/{id}/delivery/{delivery_id}/replay": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Delete order data",
+                "description": "Send a delivery of a webhook created by the caller again with a fresh set of attempts",
                 "consumes": [
                     "application/json"
                 ],
@@ -683,35 +2154,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "order"
+                    "webhook"
                 ],
-                "summary": "Delete order data",
+                "summary": "Replay webhook delivery",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "delete by id",
+                        "description": "webhook id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "delivery id",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.EmptyResponse"
+                            "$ref": "#/definitions/model.SingleWebhookDeliveryResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/model.EmptyResponse"
+                            "$ref": "#/definitions/model.SingleWebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.SingleWebhookDeliveryResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.EmptyResponse"
+                            "$ref": "#/definitions/model.SingleWebhookDeliveryResponse"
                         }
                     }
                 }
@@ -719,6 +2203,83 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "model.AuditChange": {
+            "type": "object",
+            "properties": {
+                "new": {},
+                "old": {}
+            }
+        },
+        "model.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "diff": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.AuditChange"
+                    }
+                },
+                "entity_id": {
+                    "type": "integer"
+                },
+                "entity_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuditLogsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AuditLog"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
+        "model.CancelOrder": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "model.Car": {
             "type": "object",
             "properties": {
@@ -755,6 +2316,9 @@ const docTemplate = `{
                 },
                 "updated_by": {
                     "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
@@ -870,16 +2434,199 @@ const docTemplate = `{
                     "maxLength": 50,
                     "minLength": 30
                 },
-                "pickup_long": {
-                    "type": "number",
-                    "maximum": 180,
-                    "minimum": -180
+                "pickup_long": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                }
+            }
+        },
+        "model.CreateWebhook": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.status_changed"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://partner.example.com/carrent/webhook"
+                }
+            }
+        },
+        "model.EmptyResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
+        "model.ExtendOrder": {
+            "type": "object",
+            "required": [
+                "dropoff_date"
+            ],
+            "properties": {
+                "dropoff_date": {
+                    "type": "string",
+                    "example": "2024-02-05T00:00:00Z"
+                }
+            }
+        },
+        "model.Order": {
+            "type": "object",
+            "properties": {
+                "cancellation": {
+                    "$ref": "#/definitions/model.OrderCancellation"
+                },
+                "car": {
+                    "$ref": "#/definitions/model.Car"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2019-08-13T00:00:00Z"
+                },
+                "deleted_by": {
+                    "type": "string"
+                },
+                "dropoff_date": {
+                    "type": "string"
+                },
+                "dropoff_lat": {
+                    "type": "number"
+                },
+                "dropoff_location": {
+                    "type": "string"
+                },
+                "dropoff_long": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "late_fee": {
+                    "type": "number"
+                },
+                "order_date": {
+                    "type": "string"
+                },
+                "original_dropoff_date": {
+                    "type": "string"
+                },
+                "overdue_at": {
+                    "type": "string"
+                },
+                "pickup_date": {
+                    "type": "string"
+                },
+                "pickup_lat": {
+                    "type": "number"
+                },
+                "pickup_location": {
+                    "type": "string"
+                },
+                "pickup_long": {
+                    "type": "number"
+                },
+                "price": {
+                    "$ref": "#/definitions/model.OrderPrice"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.OrderCancellation": {
+            "type": "object",
+            "properties": {
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "cancelled_by": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.OrderEvent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "data": {
+                    "$ref": "#/definitions/model.Order"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.OrderExtension": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "$ref": "#/definitions/model.Order"
+                },
+                "price_delta": {
+                    "type": "number"
                 }
             }
         },
-        "model.EmptyResponse": {
+        "model.OrderExtensionResponse": {
             "type": "object",
             "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.OrderExtension"
+                },
                 "message": {
                     "type": "string"
                 },
@@ -894,60 +2641,72 @@ const docTemplate = `{
                 }
             }
         },
-        "model.Order": {
+        "model.OrderPrice": {
             "type": "object",
             "properties": {
-                "car_id": {
+                "day_rate": {
+                    "type": "number"
+                },
+                "month_blocks": {
                     "type": "integer"
                 },
-                "created_at": {
-                    "type": "string"
+                "month_rate": {
+                    "type": "number"
                 },
-                "created_by": {
+                "remainder_days": {
                     "type": "integer"
                 },
-                "deleted_at": {
-                    "type": "string",
-                    "example": "2019-08-13T00:00:00Z"
-                },
-                "deleted_by": {
-                    "type": "string"
+                "subtotal": {
+                    "type": "number"
                 },
-                "dropoff_date": {
+                "total_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.OrderStatusHistory": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "dropoff_lat": {
-                    "type": "number"
+                "created_by": {
+                    "type": "integer"
                 },
-                "dropoff_location": {
+                "from_status": {
                     "type": "string"
                 },
-                "dropoff_long": {
-                    "type": "number"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "order_date": {
-                    "type": "string"
+                "order_id": {
+                    "type": "integer"
                 },
-                "pickup_date": {
+                "to_status": {
                     "type": "string"
+                }
+            }
+        },
+        "model.OrderStatusHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OrderStatusHistory"
+                    }
                 },
-                "pickup_lat": {
-                    "type": "number"
-                },
-                "pickup_location": {
+                "message": {
                     "type": "string"
                 },
-                "pickup_long": {
-                    "type": "number"
+                "status_code": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
                 },
-                "updated_by": {
-                    "type": "integer"
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
                 }
             }
         },
@@ -986,6 +2745,12 @@ const docTemplate = `{
                 "current_page": {
                     "type": "integer"
                 },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
                 "sort_by": {
                     "type": "string"
                 },
@@ -997,6 +2762,43 @@ const docTemplate = `{
                 }
             }
         },
+        "model.Quote": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "dropoff_date": {
+                    "type": "string"
+                },
+                "pickup_date": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/model.OrderPrice"
+                }
+            }
+        },
+        "model.QuoteResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Quote"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
         "model.SingleCarResponse": {
             "type": "object",
             "properties": {
@@ -1037,6 +2839,46 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SingleWebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.WebhookDelivery"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
+        "model.SingleWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Webhook"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
         "model.TransactionInfo": {
             "type": "object",
             "properties": {
@@ -1161,6 +3003,133 @@ const docTemplate = `{
                     "minimum": -180
                 }
             }
+        },
+        "model.Webhook": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "description": "Secret signs the deliveries of the webhook, it is only returned once\nwhen the webhook is created.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookDelivery"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
+        },
+        "model.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string",
+                    "example": "2019-08-13T00:00:00Z"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "outbox_id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Webhook"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/model.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/model.Translation"
+                }
+            }
         }
     },
     "securityDefinitions": {
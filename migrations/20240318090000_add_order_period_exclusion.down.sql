@@ -0,0 +1 @@
+ALTER TABLE "orders" DROP CONSTRAINT IF EXISTS excl_order_car_period;
@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE "orders" ADD CONSTRAINT excl_order_car_period EXCLUDE USING gist (
+  car_id WITH =,
+  daterange(pickup_date, dropoff_date, '[]') WITH &&
+) WHERE (deleted_at IS NULL);
@@ -0,0 +1,7 @@
+ALTER TABLE "orders"
+  DROP COLUMN total_days,
+  DROP COLUMN month_blocks,
+  DROP COLUMN remainder_days,
+  DROP COLUMN day_rate,
+  DROP COLUMN month_rate,
+  DROP COLUMN subtotal;
\ No newline at end of file
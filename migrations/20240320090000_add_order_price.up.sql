@@ -0,0 +1,7 @@
+ALTER TABLE "orders"
+  ADD COLUMN total_days integer DEFAULT 0 NOT NULL,
+  ADD COLUMN month_blocks integer DEFAULT 0 NOT NULL,
+  ADD COLUMN remainder_days integer DEFAULT 0 NOT NULL,
+  ADD COLUMN day_rate double precision DEFAULT 0 NOT NULL,
+  ADD COLUMN month_rate double precision DEFAULT 0 NOT NULL,
+  ADD COLUMN subtotal double precision DEFAULT 0 NOT NULL;
\ No newline at end of file
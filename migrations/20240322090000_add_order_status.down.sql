@@ -0,0 +1,10 @@
+ALTER TABLE "orders" DROP CONSTRAINT excl_order_car_period;
+
+ALTER TABLE "orders" ADD CONSTRAINT excl_order_car_period EXCLUDE USING gist (
+  car_id WITH =,
+  daterange(pickup_date, dropoff_date, '[]') WITH &&
+) WHERE (deleted_at IS NULL);
+
+ALTER TABLE "orders" DROP CONSTRAINT chk_order_status;
+
+ALTER TABLE "orders" DROP COLUMN status;
\ No newline at end of file
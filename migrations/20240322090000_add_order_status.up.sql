@@ -0,0 +1,12 @@
+ALTER TABLE "orders" ADD COLUMN status varchar(20) DEFAULT 'pending' NOT NULL;
+
+ALTER TABLE "orders" ADD CONSTRAINT chk_order_status CHECK (
+  status IN ('pending', 'confirmed', 'picked_up', 'returned', 'completed', 'cancelled')
+);
+
+ALTER TABLE "orders" DROP CONSTRAINT excl_order_car_period;
+
+ALTER TABLE "orders" ADD CONSTRAINT excl_order_car_period EXCLUDE USING gist (
+  car_id WITH =,
+  daterange(pickup_date, dropoff_date, '[]') WITH &&
+) WHERE (deleted_at IS NULL AND status <> 'cancelled');
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS order_status_history;
+DROP SEQUENCE IF EXISTS order_status_history_id_seq;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE SEQUENCE order_status_history_id_seq;
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+  id integer primary key DEFAULT nextval('order_status_history_id_seq'),
+  order_id integer NOT NULL,
+  from_status varchar(20),
+  to_status varchar(20) NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER SEQUENCE order_status_history_id_seq OWNED BY order_status_history.id;
+
+ALTER TABLE "order_status_history" ADD CONSTRAINT fk_order_status_history_order_key FOREIGN KEY("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_order_status_history_order_id ON "order_status_history" (order_id, created_at);
+
+INSERT INTO "order_status_history" (order_id, from_status, to_status, created_by, created_at)
+SELECT id, NULL, status, updated_by, updated_at FROM "orders";
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "orders"
+  DROP COLUMN cancellation_fee,
+  DROP COLUMN cancellation_reason,
+  DROP COLUMN cancelled_at,
+  DROP COLUMN cancelled_by;
\ No newline at end of file
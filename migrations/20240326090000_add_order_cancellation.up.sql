@@ -0,0 +1,5 @@
+ALTER TABLE "orders"
+  ADD COLUMN cancellation_fee double precision DEFAULT 0 NOT NULL,
+  ADD COLUMN cancellation_reason varchar(255),
+  ADD COLUMN cancelled_at timestamp WITH TIME ZONE,
+  ADD COLUMN cancelled_by integer;
\ No newline at end of file
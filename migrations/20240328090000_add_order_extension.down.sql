@@ -0,0 +1 @@
+ALTER TABLE "orders" DROP COLUMN original_dropoff_date;
\ No newline at end of file
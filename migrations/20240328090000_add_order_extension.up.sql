@@ -0,0 +1 @@
+ALTER TABLE "orders" ADD COLUMN original_dropoff_date date;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS orders_status_dropoff_date_idx;
+
+ALTER TABLE "orders"
+  DROP COLUMN overdue_at,
+  DROP COLUMN late_fee;
\ No newline at end of file
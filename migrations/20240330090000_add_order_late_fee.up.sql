@@ -0,0 +1,5 @@
+ALTER TABLE "orders"
+  ADD COLUMN overdue_at timestamp WITH TIME ZONE,
+  ADD COLUMN late_fee double precision DEFAULT 0 NOT NULL;
+
+CREATE INDEX orders_status_dropoff_date_idx ON "orders" (status, dropoff_date);
\ No newline at end of file
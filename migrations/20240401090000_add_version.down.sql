@@ -0,0 +1,3 @@
+ALTER TABLE "orders" DROP COLUMN version;
+
+ALTER TABLE "cars" DROP COLUMN version;
\ No newline at end of file
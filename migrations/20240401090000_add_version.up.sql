@@ -0,0 +1,3 @@
+ALTER TABLE "orders" ADD COLUMN version integer DEFAULT 1 NOT NULL;
+
+ALTER TABLE "cars" ADD COLUMN version integer DEFAULT 1 NOT NULL;
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS outbox;
+DROP SEQUENCE IF EXISTS outbox_id_seq;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE SEQUENCE outbox_id_seq;
+
+CREATE TABLE IF NOT EXISTS outbox (
+  id integer primary key DEFAULT nextval('outbox_id_seq'),
+  aggregate_type varchar(20) NOT NULL,
+  aggregate_id integer NOT NULL,
+  event_type varchar(50) NOT NULL,
+  payload jsonb NOT NULL,
+  status varchar(20) DEFAULT 'pending' NOT NULL,
+  attempts integer DEFAULT 0 NOT NULL,
+  last_error text,
+  available_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  published_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE outbox_id_seq OWNED BY outbox.id;
+
+CREATE INDEX idx_outbox_status_available_at ON "outbox" (status, available_at);
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP SEQUENCE IF EXISTS webhook_delivery_id_seq;
+DROP TABLE IF EXISTS webhooks;
+DROP SEQUENCE IF EXISTS webhook_id_seq;
\ No newline at end of file
@@ -0,0 +1,46 @@
+CREATE SEQUENCE webhook_id_seq;
+
+CREATE TABLE IF NOT EXISTS webhooks (
+  id integer primary key DEFAULT nextval('webhook_id_seq'),
+  url varchar(255) NOT NULL,
+  secret varchar(64) NOT NULL,
+  event_types text[] NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE webhook_id_seq OWNED BY webhooks.id;
+
+CREATE INDEX idx_webhooks_created_by ON "webhooks" (created_by);
+
+CREATE SEQUENCE webhook_delivery_id_seq;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id integer primary key DEFAULT nextval('webhook_delivery_id_seq'),
+  webhook_id integer NOT NULL,
+  outbox_id integer NOT NULL,
+  event_type varchar(50) NOT NULL,
+  payload jsonb NOT NULL,
+  status varchar(20) DEFAULT 'pending' NOT NULL,
+  attempts integer DEFAULT 0 NOT NULL,
+  response_status integer,
+  last_error text,
+  next_attempt_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  delivered_at timestamp WITH TIME ZONE,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq OWNED BY webhook_deliveries.id;
+
+ALTER TABLE "webhook_deliveries" ADD CONSTRAINT fk_webhook_delivery_webhook_key FOREIGN KEY("webhook_id") REFERENCES "webhooks" ("id") ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_webhook_deliveries_webhook_id_outbox_id ON "webhook_deliveries" (webhook_id, outbox_id);
+
+CREATE INDEX idx_webhook_deliveries_status_next_attempt_at ON "webhook_deliveries" (status, next_attempt_at);
+
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON "webhook_deliveries" (webhook_id, created_at);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS audit_log;
+DROP SEQUENCE IF EXISTS audit_log_id_seq;
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE SEQUENCE audit_log_id_seq;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+  id integer primary key DEFAULT nextval('audit_log_id_seq'),
+  entity_type varchar(20) NOT NULL,
+  entity_id integer NOT NULL,
+  action varchar(20) NOT NULL,
+  actor integer NOT NULL,
+  diff jsonb NOT NULL,
+  request_id varchar(64),
+  source varchar(20) NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER SEQUENCE audit_log_id_seq OWNED BY audit_log.id;
+
+CREATE INDEX idx_audit_log_entity ON "audit_log" (entity_type, entity_id, created_at);
+CREATE INDEX idx_audit_log_actor ON "audit_log" (actor, created_at);
+CREATE INDEX idx_audit_log_request_id ON "audit_log" (request_id);
+CREATE INDEX idx_audit_log_created_at ON "audit_log" (created_at);
\ No newline at end of file
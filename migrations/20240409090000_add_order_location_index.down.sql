@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_dropoff_point;
+DROP INDEX IF EXISTS idx_orders_pickup_point;
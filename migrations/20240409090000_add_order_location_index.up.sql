@@ -0,0 +1,2 @@
+CREATE INDEX idx_orders_pickup_point ON "orders" USING gist (point(pickup_long, pickup_lat));
+CREATE INDEX idx_orders_dropoff_point ON "orders" USING gist (point(dropoff_long, dropoff_lat));
@@ -0,0 +1 @@
+ALTER TABLE "webhooks" ADD COLUMN created_scope varchar(20) DEFAULT '' NOT NULL;
@@ -8,6 +8,8 @@ import (
 
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/achwanyusuf/carrent-ordersvc/script/cred"
+	grpcHandler "github.com/achwanyusuf/carrent-ordersvc/src/handler/grpc"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -15,9 +17,13 @@ import (
 var listener net.Listener
 
 type GRPC struct {
-	Host string
-	Port int
-	Log  logger.Logger
+	Host       string
+	Port       int
+	Log        logger.Logger
+	Permission *policy.Permission
+	// TokenSecret verifies the tokens forwarded by the rest server, which
+	// signs the audit info of its requests with it as well.
+	TokenSecret string
 }
 
 func loadTLSCredentials(serverCert string, serverKey string) (credentials.TransportCredentials, error) {
@@ -50,5 +56,15 @@ func (g *GRPC) newGRPC(serverCert string, serverKey string) *grpc.Server {
 	listener = lis
 	return grpc.NewServer(
 		grpc.Creds(tlsCredentials),
+		grpc.ChainUnaryInterceptor(
+			grpcHandler.UnaryErrorInterceptor(g.Log),
+			grpcHandler.UnaryMetadataInterceptor([]byte(g.TokenSecret)),
+			grpcHandler.UnaryPermissionInterceptor(g.Permission),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcHandler.StreamErrorInterceptor(g.Log),
+			grpcHandler.StreamMetadataInterceptor([]byte(g.TokenSecret)),
+			grpcHandler.StreamPermissionInterceptor(g.Permission),
+		),
 	)
 }
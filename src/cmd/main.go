@@ -20,7 +20,9 @@ import (
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain"
 	grpcHandler "github.com/achwanyusuf/carrent-ordersvc/src/handler/grpc"
 	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest"
+	"github.com/achwanyusuf/carrent-ordersvc/src/handler/worker"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase"
 )
 
@@ -35,6 +37,7 @@ import (
 var (
 	staticConfPath, Namespace, BuildTime, Version string
 	migrateup, migratedown, runHTTP, runGRPC      bool
+	runWorker                                     bool
 	OAuth2PasswordTokenUrl                        string
 )
 
@@ -44,6 +47,7 @@ func main() {
 	flag.BoolVar(&migratedown, "migratedown", false, "run migration up")
 	flag.BoolVar(&runHTTP, "http", true, "run http")
 	flag.BoolVar(&runGRPC, "grpc", false, "run grpc")
+	flag.BoolVar(&runWorker, "worker", false, "run worker")
 	flag.Parse()
 	cfg, err := conf.New(staticConfPath)
 	if err != nil {
@@ -99,13 +103,17 @@ func main() {
 		Credential:        tlsCredentials,
 	})
 
+	// permissions of every role, checked by both the http and grpc server
+	permission := policy.New(cfg.Permission)
+
 	// init domain
 	dom := domain.New(&domain.DomainDep{
-		Conf:  cfg.Domain,
-		Log:   &log,
-		DB:    psql,
-		Redis: redis,
-		Grpc:  grpcClient,
+		Conf:       cfg.Domain,
+		Log:        &log,
+		DB:         psql,
+		Redis:      redis,
+		Grpc:       grpcClient,
+		Permission: permission,
 	})
 
 	// init usecase
@@ -127,9 +135,11 @@ func main() {
 		go func() {
 			// setup grpc connection
 			grpcSetting := GRPC{
-				Host: cfg.App.GRPC.Host,
-				Port: cfg.App.GRPC.Port,
-				Log:  log,
+				Host:        cfg.App.GRPC.Host,
+				Port:        cfg.App.GRPC.Port,
+				Log:         log,
+				Permission:  permission,
+				TokenSecret: cfg.Rest.TokenSecret,
 			}
 			grpc := grpcSetting.newGRPC(cfg.App.GRPC.ServerCert, cfg.App.GRPC.ServerKey)
 			grpcmodel.RegisterOrderServer(grpc, grpcHandler.New(grpcHandler.Config{}, &log, uc))
@@ -163,11 +173,12 @@ func main() {
 
 			// init http router
 			restCfg := rest.RestDep{
-				Conf:     cfg.Rest,
-				Log:      &log,
-				Usecase:  uc,
-				Gin:      gin,
-				Validate: validate,
+				Conf:       cfg.Rest,
+				Log:        &log,
+				Usecase:    uc,
+				Gin:        gin,
+				Validate:   validate,
+				Permission: permission,
 			}
 			handler := rest.New(&restCfg)
 
@@ -176,12 +187,21 @@ func main() {
 		}()
 	}
 
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	if runWorker {
+		go func() {
+			// run background jobs
+			worker.New(cfg.Worker, &log, uc).Run(workerCtx)
+		}()
+	}
+
 	<-readSignal
 
 	log.Warn(context.Background(), "closing gracefully . . . ")
 	st := time.Now()
 
 	// close all connection here before shutdown
+	stopWorker()
 	psql.Close()
 	redis.Close()
 	grpcClient.Release()
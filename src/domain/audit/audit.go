@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/grpcclientpool"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+)
+
+type AuditDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+	Grpc *grpcclientpool.CPool
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+type AuditInterface interface {
+	GetByParam(ctx *context.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error)
+
+	// grpc client
+	GetByParamGRPC(ctx context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error)
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, grpc *grpcclientpool.CPool) AuditInterface {
+	return &AuditDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+		Grpc: grpc,
+	}
+}
+
+// GetByParam returns the audit logs matching param, newest first. The logs
+// are written by the car and order domains along with the change they record.
+func (a *AuditDep) GetByParam(ctx *context.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error) {
+	return a.getByParamPSQL(ctx, param)
+}
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+func (a *AuditDep) GetByParamGRPC(ctx context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error) {
+	client, err := a.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.GetAuditLogs(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
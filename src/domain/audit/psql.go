@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (a *AuditDep) getByParamPSQL(ctx *context.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(a.Conf.DefaultPageLimit)
+		if a.Conf.DefaultPageLimit == 0 {
+			param.Limit = int64(model.DefaultPageLimit)
+		}
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := psqlmodel.AuditLogs(qr...).Count(*ctx, a.DB)
+	if err != nil {
+		return psqlmodel.AuditLogSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error count data")
+	}
+	qr = append(qr, qm.OrderBy("id DESC"))
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	auditLogs, err := psqlmodel.AuditLogs(qr...).All(*ctx, a.DB)
+	if err != nil {
+		return auditLogs, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get audit logs")
+	}
+	if count > 0 {
+		totalPages = (count + param.Limit - 1) / param.Limit
+	}
+	return auditLogs, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(auditLogs)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          "id DESC",
+	}, nil
+}
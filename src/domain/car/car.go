@@ -16,6 +16,7 @@ import (
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
 
 	goredislib "github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 )
 
 type CarDep struct {
@@ -24,11 +25,21 @@ type CarDep struct {
 	Redis *goredislib.Client
 	Conf  Conf
 	Grpc  *grpcclientpool.CPool
+
+	flight singleflight.Group
 }
 
 type Conf struct {
 	DefaultPageLimit    int           `mapstructure:"page_limit"`
 	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
+	// SingleFlight coalesces identical list cache misses into one psql read.
+	SingleFlight bool `mapstructure:"single_flight"`
+	// StaleWhileRevalidate keeps list entries this long past their expiration,
+	// a stale entry is served while it is refreshed in the background.
+	StaleWhileRevalidate time.Duration `mapstructure:"stale_while_revalidate"`
+	// FlightTimeout bounds a shared list read, it does not end with the
+	// caller that started it.
+	FlightTimeout time.Duration `mapstructure:"flight_timeout"`
 }
 
 type CarInterface interface {
@@ -37,11 +48,17 @@ type CarInterface interface {
 	Update(ctx *context.Context, v *psqlmodel.Car) error
 	Delete(ctx *context.Context, v *psqlmodel.Car, id int64, isHardDelete bool) error
 	GetByParam(ctx *context.Context, cacheControl string, param *model.GetCarsByParam) (psqlmodel.CarSlice, model.Pagination, error)
+	GetAvailable(ctx *context.Context, param *model.GetAvailableCarsByParam) (psqlmodel.CarSlice, model.Pagination, error)
+
+	ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error)
+	SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error
+	ReleaseIdempotencyKey(ctx *context.Context, key string) error
 
 	// grpc client
 	InsertGRPC(ctx context.Context, v *grpcmodel.CreateCarRequest) (*grpcmodel.SingleCarReply, error)
 	GetByIDGRPC(ctx context.Context, v *grpcmodel.GetCarByIDRequest) (*grpcmodel.SingleCarReply, error)
 	GetCarByParam(ctx context.Context, v *grpcmodel.GetCarByParamRequest) (*grpcmodel.GetCarByParamReply, error)
+	GetAvailableCars(ctx context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error)
 	DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteCarRequest) (*grpcmodel.DeleteCarReply, error)
 	UpdateGRPC(ctx context.Context, v *grpcmodel.UpdateCarRequest) (*grpcmodel.SingleCarReply, error)
 }
@@ -57,7 +74,51 @@ func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client, grpc
 }
 
 func (c *CarDep) Insert(ctx *context.Context, data *psqlmodel.Car) error {
-	return c.insertPSQL(ctx, data)
+	err := c.insertPSQL(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	c.invalidateCache(ctx)
+	return nil
+}
+
+// ReserveIdempotencyKey stores record under key unless the key already exists,
+// in which case the stored record is returned and reserved is false.
+func (c *CarDep) ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	reserved, err := c.reserveIdempotencyRedis(ctx, key, record)
+	if err != nil {
+		return record, false, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error reserve idempotency key")
+	}
+
+	if reserved {
+		return record, true, nil
+	}
+
+	stored, err := c.getIdempotencyRedis(ctx, key)
+	if err != nil {
+		return stored, false, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get idempotency key")
+	}
+
+	return stored, false, nil
+}
+
+func (c *CarDep) SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	err := c.setIdempotencyRedis(ctx, key, record)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error save idempotency key")
+	}
+
+	return nil
+}
+
+func (c *CarDep) ReleaseIdempotencyKey(ctx *context.Context, key string) error {
+	_, err := c.Redis.Del(*ctx, key).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error release idempotency key")
+	}
+
+	return nil
 }
 
 func (c *CarDep) GetSingleByParam(ctx *context.Context, cacheControl string, param *model.GetCarByParam) (psqlmodel.Car, error) {
@@ -66,7 +127,13 @@ func (c *CarDep) GetSingleByParam(ctx *context.Context, cacheControl string, par
 		return psqlmodel.Car{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 	}
 
-	key := fmt.Sprintf(model.GetSingleByParamCarKey, str)
+	ns, err := c.getCacheNamespaceRedis(ctx)
+	if err != nil {
+		c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache namespace"))
+		return c.getSingleByParamPSQL(ctx, param)
+	}
+
+	key := fmt.Sprintf(model.GetSingleByParamCarKey, ns, str)
 	if cacheControl != model.MustRevalidate {
 		res, err := c.getSingleByParamRedis(ctx, key)
 		if err != nil {
@@ -104,72 +171,151 @@ func (c *CarDep) GetSingleByParam(ctx *context.Context, cacheControl string, par
 }
 
 func (c *CarDep) Update(ctx *context.Context, v *psqlmodel.Car) error {
-	return c.updatePSQL(ctx, v)
+	err := c.updatePSQL(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	c.invalidateCache(ctx)
+	return nil
 }
 
 func (c *CarDep) Delete(ctx *context.Context, v *psqlmodel.Car, id int64, isHardDelete bool) error {
-	return c.deletePSQL(ctx, v, id, isHardDelete)
+	err := c.deletePSQL(ctx, v, id, isHardDelete)
+	if err != nil {
+		return err
+	}
+
+	c.invalidateCache(ctx)
+	return nil
 }
 func (c *CarDep) GetByParam(ctx *context.Context, cacheControl string, param *model.GetCarsByParam) (psqlmodel.CarSlice, model.Pagination, error) {
 	var pg model.Pagination
-	var res psqlmodel.CarSlice
 
 	str, err := json.Marshal(param)
 	if err != nil {
 		return psqlmodel.CarSlice{}, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 	}
 
-	key := fmt.Sprintf(model.GetByParamCarKey, str)
-	keyPg := fmt.Sprintf(model.GetByParamCarPgKey, str)
+	ns, err := c.getCacheNamespaceRedis(ctx)
+	if err != nil {
+		c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache namespace"))
+		return c.getByParamPSQL(ctx, param)
+	}
+
+	key := fmt.Sprintf(model.GetByParamCarKey, ns, str)
+	keyPg := fmt.Sprintf(model.GetByParamCarPgKey, ns, str)
 	if cacheControl != model.MustRevalidate {
 		res, err1 := c.getByParamRedis(ctx, key)
 		pg, err2 := c.getByParamPaginationRedis(ctx, keyPg)
 		if err1 != nil || err2 != nil {
 			if err1 == goredislib.Nil || err2 == goredislib.Nil {
-				res, pg, err := c.getByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-					}
-					err = c.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-					}
-					dataStr, err = json.Marshal(&pg)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-					}
-					err = c.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-					}
+				if c.Conf.SingleFlight {
+					return c.flightByParam(ctx, param, key, keyPg)
 				}
-				return res, pg, err
+				return c.loadByParam(ctx, param, key, keyPg)
 			}
 			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 		}
+
+		if c.Conf.StaleWhileRevalidate > 0 {
+			stale, err := c.isStaleRedis(ctx, key)
+			if err != nil {
+				c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache ttl"))
+			}
+			if stale {
+				c.revalidateByParam(param, key, keyPg)
+			}
+		}
 		return res, pg, nil
 	}
 
-	res, pg, err = c.getByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-		}
-		err = c.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-		}
-		dataStr, err = json.Marshal(&pg)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	return c.loadByParam(ctx, param, key, keyPg)
+}
+
+// loadByParam reads the list from psql and caches it with its pagination.
+func (c *CarDep) loadByParam(ctx *context.Context, param *model.GetCarsByParam, key, keyPg string) (psqlmodel.CarSlice, model.Pagination, error) {
+	res, pg, err := c.getByParamPSQL(ctx, param)
+	if err != nil {
+		return res, pg, err
+	}
+
+	dataStr, err := json.Marshal(&res)
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	}
+	err = c.setByParamRedis(ctx, key, string(dataStr))
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+	}
+	dataStr, err = json.Marshal(&pg)
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	}
+	err = c.setByParamRedis(ctx, keyPg, string(dataStr))
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+	}
+	return res, pg, nil
+}
+
+type byParamResult struct {
+	res psqlmodel.CarSlice
+	pg  model.Pagination
+}
+
+// flightByParam is loadByParam shared by every concurrent call for the same
+// key, so a popular entry expiring costs a single psql read. The read runs on
+// its own context so a caller going away does not fail the others, and each
+// caller stops waiting once its own ctx is done.
+func (c *CarDep) flightByParam(ctx *context.Context, param *model.GetCarsByParam, key, keyPg string) (psqlmodel.CarSlice, model.Pagination, error) {
+	p := *param
+	ch := c.flight.DoChan(key, func() (interface{}, error) {
+		flightTimeout := c.Conf.FlightTimeout
+		if flightTimeout == 0 {
+			flightTimeout = model.DefaultFlightTimeout
 		}
-		err = c.setRedis(ctx, key, string(dataStr))
+		flightCtx, cancel := context.WithTimeout(context.Background(), flightTimeout)
+		defer cancel()
+		res, pg, err := c.loadByParam(&flightCtx, &p, key, keyPg)
+		return byParamResult{res: res, pg: pg}, err
+	})
+
+	select {
+	case r := <-ch:
+		result := r.Val.(byParamResult)
+		return result.res, result.pg, r.Err
+	case <-(*ctx).Done():
+		return psqlmodel.CarSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, (*ctx).Err(), "error wait psql")
+	}
+}
+
+// revalidateByParam refreshes a stale list entry in the background while the
+// stale value is served.
+func (c *CarDep) revalidateByParam(param *model.GetCarsByParam, key, keyPg string) {
+	p := *param
+	go func() {
+		ctx := context.Background()
+		_, _, err := c.flightByParam(&ctx, &p, key, keyPg)
 		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+			c.Log.Warn(ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error revalidate cache"))
 		}
+	}()
+}
+
+// GetAvailable always reads from psql, availability changes with every order
+// so it is not cached.
+func (c *CarDep) GetAvailable(ctx *context.Context, param *model.GetAvailableCarsByParam) (psqlmodel.CarSlice, model.Pagination, error) {
+	return c.getAvailablePSQL(ctx, param)
+}
+
+// invalidateCache bumps the car cache namespace so every cached single and
+// list entry is bypassed, it is called after each write. The write has already
+// succeeded so a failure is only logged, stale entries then live until they
+// expire.
+func (c *CarDep) invalidateCache(ctx *context.Context) {
+	err := c.bumpCacheNamespaceRedis(ctx)
+	if err != nil {
+		c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error invalidate cache"))
 	}
-	return res, pg, err
 }
@@ -2,8 +2,11 @@ package car_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"sync"
 	"testing"
 	"time"
 
@@ -134,3 +137,381 @@ func TestGetSingleParam(t *testing.T) {
 		}
 	})
 }
+
+func TestCacheNamespace(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := car.CarDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: car.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	data := psqlmodel.Car{
+		ID:        1,
+		CarName:   "sedan",
+		DayRate:   1.2,
+		MonthRate: 7.1,
+		Image:     "http://link",
+		CreatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+		Version:   1,
+	}
+	columns := []string{"id", "car_name", "day_rate", "month_rate", "image", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "version"}
+	newRows := func() *gosqlmock.Rows {
+		return sqlMock.NewRows(columns).AddRow(data.ID, data.CarName, data.DayRate, data.MonthRate, data.Image, data.CreatedBy, data.CreatedAt, data.UpdatedBy, data.UpdatedAt, data.DeletedBy, data.DeletedAt, data.Version)
+	}
+	singleParam := &model.GetCarByParam{
+		ID: null.NewInt64(1, true),
+	}
+	singleStr, _ := json.Marshal(singleParam)
+	dataStr, _ := json.Marshal(&data)
+
+	Convey("test cache namespace", t, FailureHalts, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			mockFunc func()
+			call     func() (psqlmodel.Car, error)
+		}{
+			{
+				testType: "P",
+				testDesc: "test get single read from current namespace",
+				mockFunc: func() {
+					redisMock.ExpectGet(model.CarCacheNamespaceKey).SetVal("3")
+					redisMock.ExpectGet(fmt.Sprintf(model.GetSingleByParamCarKey, 3, singleStr)).SetVal(string(dataStr))
+				},
+				call: func() (psqlmodel.Car, error) {
+					return acc.GetSingleByParam(&ctx, "", singleParam)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test update bump namespace",
+				mockFunc: func() {
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\" WHERE (id=$1) AND (\"cars\".\"deleted_at\" is null) LIMIT 1 FOR UPDATE;")).WithArgs(data.ID).WillReturnRows(newRows())
+					sqlMock.ExpectExec(regexp.QuoteMeta("UPDATE \"cars\" SET")).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"outbox\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "attempts", "last_error", "available_at", "published_at"}).AddRow(1, 0, nil, time.Now(), nil))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"audit_log\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "request_id"}).AddRow(1, nil))
+					sqlMock.ExpectCommit()
+					redisMock.ExpectIncr(model.CarCacheNamespaceKey).SetVal(4)
+				},
+				call: func() (psqlmodel.Car, error) {
+					v := data
+					err := acc.Update(&ctx, &v)
+					return v, err
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test get single after update miss cache of new namespace",
+				mockFunc: func() {
+					key := fmt.Sprintf(model.GetSingleByParamCarKey, 4, singleStr)
+					redisMock.ExpectGet(model.CarCacheNamespaceKey).SetVal("4")
+					redisMock.ExpectGet(key).RedisNil()
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\" WHERE (id=$1) AND (\"cars\".\"deleted_at\" is null) LIMIT 1;")).WithArgs(data.ID).WillReturnRows(newRows())
+					redisMock.ExpectDel(key).SetVal(0)
+					redisMock.ExpectSet(key, string(dataStr), 30*time.Second).SetVal("OK")
+				},
+				call: func() (psqlmodel.Car, error) {
+					return acc.GetSingleByParam(&ctx, "", singleParam)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test get single bypass cache when namespace unavailable",
+				mockFunc: func() {
+					redisMock.ExpectGet(model.CarCacheNamespaceKey).SetErr(errors.New("connection refused"))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\" WHERE (id=$1) AND (\"cars\".\"deleted_at\" is null) LIMIT 1;")).WithArgs(data.ID).WillReturnRows(newRows())
+				},
+				call: func() (psqlmodel.Car, error) {
+					return acc.GetSingleByParam(&ctx, "", singleParam)
+				},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				test.mockFunc()
+				res, err := test.call()
+				So(err, ShouldBeNil)
+				So(res.ID, ShouldEqual, data.ID)
+				So(res.CarName, ShouldEqual, data.CarName)
+				So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
+
+func TestGetByParamCache(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := car.CarDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: car.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	Convey("test get by param cache", t, FailureHalts, func() {
+		Convey("0 - [P] : test list and pagination cached under their own keys", func() {
+			param := &model.GetCarsByParam{
+				GetCarByParam: model.GetCarByParam{
+					CarName: null.StringFrom("sedan"),
+				},
+			}
+			str, _ := json.Marshal(param)
+			key := fmt.Sprintf(model.GetByParamCarKey, 2, str)
+			keyPg := fmt.Sprintf(model.GetByParamCarPgKey, 2, str)
+			data := psqlmodel.CarSlice{
+				{
+					ID:        1,
+					CarName:   "sedan",
+					CreatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+				},
+			}
+			pg := model.Pagination{
+				CurrentPage:     1,
+				CurrentElements: 1,
+				TotalPages:      1,
+				TotalElements:   1,
+			}
+			dataStr, _ := json.Marshal(&data)
+			pgStr, _ := json.Marshal(&pg)
+
+			redisMock.ExpectGet(model.CarCacheNamespaceKey).SetVal("2")
+			redisMock.ExpectGet(key).RedisNil()
+			redisMock.ExpectGet(keyPg).RedisNil()
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(1))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_name", "created_at", "updated_at"}).AddRow(data[0].ID, data[0].CarName, data[0].CreatedAt, data[0].UpdatedAt))
+			redisMock.ExpectDel(key).SetVal(0)
+			redisMock.ExpectSet(key, string(dataStr), 30*time.Second).SetVal("OK")
+			redisMock.ExpectDel(keyPg).SetVal(0)
+			redisMock.ExpectSet(keyPg, string(pgStr), 30*time.Second).SetVal("OK")
+
+			res, resPg, err := acc.GetByParam(&ctx, "", param)
+			So(err, ShouldBeNil)
+			So(len(res), ShouldEqual, 1)
+			So(resPg, ShouldResemble, pg)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestGetByParamSingleFlight(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := car.CarDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: car.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+			SingleFlight:        true,
+		},
+	}
+	ctx := context.Background()
+	Convey("test get by param single flight", t, FailureHalts, func() {
+		Convey("0 - [P] : test concurrent cache miss read psql once", func() {
+			calls := 5
+			str, _ := json.Marshal(&model.GetCarsByParam{})
+			key := fmt.Sprintf(model.GetByParamCarKey, 0, str)
+			keyPg := fmt.Sprintf(model.GetByParamCarPgKey, 0, str)
+
+			redisMock.MatchExpectationsInOrder(false)
+			for i := 0; i < calls; i++ {
+				redisMock.ExpectGet(model.CarCacheNamespaceKey).RedisNil()
+				redisMock.ExpectGet(key).RedisNil()
+				redisMock.ExpectGet(keyPg).RedisNil()
+			}
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM \"cars\"")).WillDelayFor(200 * time.Millisecond).WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(1))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_name"}).AddRow(1, "sedan"))
+			redisMock.Regexp().ExpectDel(key).SetVal(0)
+			redisMock.Regexp().ExpectSet(regexp.QuoteMeta(key), ".*", 30*time.Second).SetVal("OK")
+			redisMock.Regexp().ExpectDel(keyPg).SetVal(0)
+			redisMock.Regexp().ExpectSet(regexp.QuoteMeta(keyPg), ".*", 30*time.Second).SetVal("OK")
+
+			var wg sync.WaitGroup
+			errs := make([]error, calls)
+			results := make([]psqlmodel.CarSlice, calls)
+			for i := 0; i < calls; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i], _, errs[i] = acc.GetByParam(&ctx, "", &model.GetCarsByParam{})
+				}(i)
+			}
+			wg.Wait()
+
+			for i := 0; i < calls; i++ {
+				So(errs[i], ShouldBeNil)
+				So(len(results[i]), ShouldEqual, 1)
+			}
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("1 - [N] : test cancelled caller stop waiting while psql read is cached", func() {
+			str, _ := json.Marshal(&model.GetCarsByParam{})
+			key := fmt.Sprintf(model.GetByParamCarKey, 0, str)
+			keyPg := fmt.Sprintf(model.GetByParamCarPgKey, 0, str)
+
+			redisMock.ExpectGet(model.CarCacheNamespaceKey).RedisNil()
+			redisMock.ExpectGet(key).RedisNil()
+			redisMock.ExpectGet(keyPg).RedisNil()
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM \"cars\"")).WillDelayFor(200 * time.Millisecond).WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(1))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_name"}).AddRow(1, "sedan"))
+			redisMock.Regexp().ExpectDel(key).SetVal(0)
+			redisMock.Regexp().ExpectSet(regexp.QuoteMeta(key), ".*", 30*time.Second).SetVal("OK")
+			redisMock.Regexp().ExpectDel(keyPg).SetVal(0)
+			redisMock.Regexp().ExpectSet(regexp.QuoteMeta(keyPg), ".*", 30*time.Second).SetVal("OK")
+
+			callerCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			_, _, err := acc.GetByParam(&callerCtx, "", &model.GetCarsByParam{})
+			So(err, ShouldNotBeNil)
+			So(time.Since(start), ShouldBeLessThan, 200*time.Millisecond)
+
+			time.Sleep(400 * time.Millisecond)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestGetByParamStaleWhileRevalidate(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := car.CarDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: car.Conf{
+			DefaultPageLimit:     10,
+			RedisExpirationTime:  30 * time.Second,
+			StaleWhileRevalidate: 10 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	param := &model.GetCarsByParam{}
+	str, _ := json.Marshal(param)
+	key := fmt.Sprintf(model.GetByParamCarKey, 0, str)
+	keyPg := fmt.Sprintf(model.GetByParamCarPgKey, 0, str)
+	cached := psqlmodel.CarSlice{{ID: 1, CarName: "sedan"}}
+	cachedStr, _ := json.Marshal(&cached)
+	cachedPg := model.Pagination{CurrentPage: 1, CurrentElements: 1, TotalPages: 1, TotalElements: 1}
+	cachedPgStr, _ := json.Marshal(&cachedPg)
+
+	Convey("test get by param stale while revalidate", t, FailureHalts, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			mockFunc func()
+		}{
+			{
+				testType: "P",
+				testDesc: "test fresh entry served without refresh",
+				mockFunc: func() {
+					redisMock.ExpectPTTL(key).SetVal(35 * time.Second)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test entry without expiration served without refresh",
+				mockFunc: func() {
+					redisMock.ExpectPTTL(key).SetVal(-1)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test entry expired meanwhile served without refresh",
+				mockFunc: func() {
+					redisMock.ExpectPTTL(key).SetVal(-2)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test stale entry served and refreshed in background",
+				mockFunc: func() {
+					redisMock.ExpectPTTL(key).SetVal(5 * time.Second)
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(2))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"cars\".* FROM \"cars\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_name"}).AddRow(1, "sedan").AddRow(2, "suv"))
+					redisMock.ExpectDel(key).SetVal(1)
+					redisMock.Regexp().ExpectSet(regexp.QuoteMeta(key), ".*", 40*time.Second).SetVal("OK")
+					redisMock.ExpectDel(keyPg).SetVal(1)
+					redisMock.Regexp().ExpectSet(regexp.QuoteMeta(keyPg), ".*", 40*time.Second).SetVal("OK")
+				},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				redisMock.ExpectGet(model.CarCacheNamespaceKey).RedisNil()
+				redisMock.ExpectGet(key).SetVal(string(cachedStr))
+				redisMock.ExpectGet(keyPg).SetVal(string(cachedPgStr))
+				test.mockFunc()
+
+				res, pg, err := acc.GetByParam(&ctx, "", param)
+				So(err, ShouldBeNil)
+				So(len(res), ShouldEqual, 1)
+				So(pg, ShouldResemble, cachedPg)
+
+				for i := 0; i < 100 && redisMock.ExpectationsWereMet() != nil; i++ {
+					time.Sleep(10 * time.Millisecond)
+				}
+				So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
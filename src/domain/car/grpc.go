@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
 )
@@ -17,11 +18,11 @@ func (c *CarDep) InsertGRPC(ctx context.Context, v *grpcmodel.CreateCarRequest)
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.CreateCar(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -36,11 +37,11 @@ func (c *CarDep) UpdateGRPC(ctx context.Context, v *grpcmodel.UpdateCarRequest)
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.UpdateCar(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -55,11 +56,11 @@ func (c *CarDep) DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteCarRequest)
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.DeleteCar(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -74,11 +75,11 @@ func (c *CarDep) GetByIDGRPC(ctx context.Context, v *grpcmodel.GetCarByIDRequest
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.GetCarByID(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -93,11 +94,30 @@ func (c *CarDep) GetCarByParam(ctx context.Context, v *grpcmodel.GetCarByParamRe
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.GetCarByParam(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (c *CarDep) GetAvailableCars(ctx context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error) {
+	client, err := c.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.GetAvailableCars(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -26,6 +26,23 @@ func (c *CarDep) insertPSQL(ctx *context.Context, data *psqlmodel.Car) error {
 		}
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert")
 	}
+
+	err = c.insertOutboxPSQL(ctx, tx, model.CarEventCreated, data)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = c.insertAuditPSQL(ctx, tx, model.AuditActionInsert, data.CreatedBy, nil, data)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -54,6 +71,22 @@ func (c *CarDep) updatePSQL(ctx *context.Context, car *psqlmodel.Car) error {
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
+	current, err := psqlmodel.Cars(qm.Where("id=?", car.ID), qm.For("UPDATE")).One(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get car")
+	}
+
+	if current.Version != car.Version {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCVersionMismatch, nil, "car has been changed since it was read")
+	}
+	car.Version = current.Version + 1
+
 	_, err = car.Update(*ctx, tx, boil.Infer())
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
@@ -61,6 +94,23 @@ func (c *CarDep) updatePSQL(ctx *context.Context, car *psqlmodel.Car) error {
 		}
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
 	}
+
+	err = c.insertOutboxPSQL(ctx, tx, model.CarEventUpdated, car)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = c.insertAuditPSQL(ctx, tx, model.AuditActionUpdate, car.UpdatedBy, current, car)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -69,6 +119,7 @@ func (c *CarDep) updatePSQL(ctx *context.Context, car *psqlmodel.Car) error {
 }
 
 func (c *CarDep) deletePSQL(ctx *context.Context, car *psqlmodel.Car, id int64, isHardDelete bool) error {
+	before := *car
 	tx, err := c.DB.BeginTx(*ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
@@ -92,6 +143,27 @@ func (c *CarDep) deletePSQL(ctx *context.Context, car *psqlmodel.Car, id int64,
 			return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
 		}
 	}
+
+	err = c.insertOutboxPSQL(ctx, tx, model.CarEventDeleted, car)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	after := car
+	if isHardDelete {
+		after = nil
+	}
+	err = c.insertAuditPSQL(ctx, tx, model.AuditActionDelete, int(id), &before, after)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			c.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -100,6 +172,14 @@ func (c *CarDep) deletePSQL(ctx *context.Context, car *psqlmodel.Car, id int64,
 }
 
 func (c *CarDep) getByParamPSQL(ctx *context.Context, param *model.GetCarsByParam) (psqlmodel.CarSlice, model.Pagination, error) {
+	return c.getPagedPSQL(ctx, param, param.GetQuery())
+}
+
+func (c *CarDep) getAvailablePSQL(ctx *context.Context, param *model.GetAvailableCarsByParam) (psqlmodel.CarSlice, model.Pagination, error) {
+	return c.getPagedPSQL(ctx, &param.GetCarsByParam, param.GetQuery())
+}
+
+func (c *CarDep) getPagedPSQL(ctx *context.Context, param *model.GetCarsByParam, qr []qm.QueryMod) (psqlmodel.CarSlice, model.Pagination, error) {
 	var totalPages int64 = 1
 	if param.Limit == 0 {
 		param.Limit = int64(c.Conf.DefaultPageLimit)
@@ -108,11 +188,14 @@ func (c *CarDep) getByParamPSQL(ctx *context.Context, param *model.GetCarsByPara
 		}
 	}
 
+	if param.IsCursor() {
+		return c.getCursorPSQL(ctx, param, qr)
+	}
+
 	if param.Page == 0 {
 		param.Page = 1
 	}
 
-	qr := param.GetQuery()
 	count, err := psqlmodel.Cars(qr...).Count(*ctx, c.DB)
 	if err != nil {
 		return psqlmodel.CarSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error count data")
@@ -127,7 +210,7 @@ func (c *CarDep) getByParamPSQL(ctx *context.Context, param *model.GetCarsByPara
 		return cars, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cars")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = (count + param.Limit - 1) / param.Limit
 	}
 	return cars, model.Pagination{
 		CurrentPage:     param.Page,
@@ -137,3 +220,73 @@ func (c *CarDep) getByParamPSQL(ctx *context.Context, param *model.GetCarsByPara
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getCursorPSQL reads the cursor page of param among the cars of qr without
+// counting them, the page is read backwards when it goes back and put in
+// order here.
+func (c *CarDep) getCursorPSQL(ctx *context.Context, param *model.GetCarsByParam, qr []qm.QueryMod) (psqlmodel.CarSlice, model.Pagination, error) {
+	page, err := param.GetCursorPage()
+	if err != nil {
+		return psqlmodel.CarSlice{}, model.Pagination{}, err
+	}
+
+	qr = append(qr, page.GetQuery()...)
+	cars, err := psqlmodel.Cars(qr...).All(*ctx, c.DB)
+	if err != nil {
+		return cars, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cars")
+	}
+
+	hasMore := int64(len(cars)) > page.Limit
+	if hasMore {
+		cars = cars[:page.Limit]
+	}
+
+	if page.IsPrev() {
+		for i, j := 0, len(cars)-1; i < j; i, j = i+1, j-1 {
+			cars[i], cars[j] = cars[j], cars[i]
+		}
+	}
+
+	var first, last interface{}
+	if len(cars) > 0 {
+		first, last = cars[0], cars[len(cars)-1]
+	}
+
+	pagination, err := page.GetPagination(first, last, len(cars), hasMore)
+	return cars, pagination, err
+}
+
+// insertOutboxPSQL records an event on the car within tx, it is published by
+// the outbox relay once tx commits.
+func (c *CarDep) insertOutboxPSQL(ctx *context.Context, tx *sql.Tx, eventType string, car *psqlmodel.Car) error {
+	outbox, err := model.NewOutbox(model.OutboxAggregateCar, car.ID, eventType, car)
+	if err != nil {
+		return err
+	}
+
+	err = outbox.Insert(*ctx, tx, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert outbox")
+	}
+	return nil
+}
+
+// insertAuditPSQL records the change of a car made by actor within tx, before
+// is nil for an insert and after is nil for a hard delete.
+func (c *CarDep) insertAuditPSQL(ctx *context.Context, tx *sql.Tx, action string, actor int, before, after *psqlmodel.Car) error {
+	car := after
+	if car == nil {
+		car = before
+	}
+
+	audit, err := model.NewAuditLog(*ctx, model.AuditEntityCar, car.ID, action, actor, before, after)
+	if err != nil {
+		return err
+	}
+
+	err = audit.Insert(*ctx, tx, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert audit log")
+	}
+	return nil
+}
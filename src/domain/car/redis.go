@@ -6,6 +6,8 @@ import (
 
 	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+
+	goredislib "github.com/redis/go-redis/v9"
 )
 
 func (c *CarDep) getSingleByParamRedis(ctx *context.Context, key string) (psqlmodel.Car, error) {
@@ -34,6 +36,32 @@ func (c *CarDep) setRedis(ctx *context.Context, key string, data string) error {
 	return err
 }
 
+// setByParamRedis stores a list entry, with stale while revalidate the entry is
+// kept past its expiration for the stale window.
+func (c *CarDep) setByParamRedis(ctx *context.Context, key string, data string) error {
+	expTime := c.Conf.RedisExpirationTime
+	if c.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultRedisExpiration
+	}
+	_, err := c.Redis.Del(*ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	_, err = c.Redis.Set(*ctx, key, data, expTime+c.Conf.StaleWhileRevalidate).Result()
+	return err
+}
+
+// isStaleRedis reports whether the entry under key has outlived its expiration
+// and is only kept for the stale window. A key without an expiration (-1) or
+// one already gone (-2) is never stale.
+func (c *CarDep) isStaleRedis(ctx *context.Context, key string) (bool, error) {
+	ttl, err := c.Redis.PTTL(*ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return ttl >= 0 && ttl < c.Conf.StaleWhileRevalidate, nil
+}
+
 func (c *CarDep) getByParamRedis(ctx *context.Context, key string) (psqlmodel.CarSlice, error) {
 	var res psqlmodel.CarSlice
 	data, err := c.Redis.Get(*ctx, key).Result()
@@ -59,3 +87,48 @@ func (c *CarDep) getByParamPaginationRedis(ctx *context.Context, key string) (mo
 	}
 	return res, nil
 }
+
+func (c *CarDep) reserveIdempotencyRedis(ctx *context.Context, key string, record model.IdempotencyRecord) (bool, error) {
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return false, err
+	}
+	return c.Redis.SetNX(*ctx, key, string(data), model.IdempotencyExpiration).Result()
+}
+
+func (c *CarDep) getIdempotencyRedis(ctx *context.Context, key string) (model.IdempotencyRecord, error) {
+	var res model.IdempotencyRecord
+	data, err := c.Redis.Get(*ctx, key).Result()
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal([]byte(data), &res)
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (c *CarDep) setIdempotencyRedis(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	_, err = c.Redis.Set(*ctx, key, string(data), model.IdempotencyExpiration).Result()
+	return err
+}
+
+// getCacheNamespaceRedis returns the current version of the car cache, a
+// missing namespace key is version 0.
+func (c *CarDep) getCacheNamespaceRedis(ctx *context.Context) (int64, error) {
+	ns, err := c.Redis.Get(*ctx, model.CarCacheNamespaceKey).Int64()
+	if err == goredislib.Nil {
+		return 0, nil
+	}
+	return ns, err
+}
+
+func (c *CarDep) bumpCacheNamespaceRedis(ctx *context.Context) error {
+	_, err := c.Redis.Incr(*ctx, model.CarCacheNamespaceKey).Result()
+	return err
+}
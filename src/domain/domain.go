@@ -5,8 +5,12 @@ import (
 
 	"github.com/achwanyusuf/carrent-lib/pkg/grpcclientpool"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/audit"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain/car"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain/order"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/outbox"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/webhook"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	goredislib "github.com/redis/go-redis/v9"
 )
 
@@ -16,21 +20,42 @@ type DomainDep struct {
 	DB    *sql.DB
 	Redis *goredislib.Client
 	Grpc  *grpcclientpool.CPool
+	// Permission grants the roles their actions, webhooks only receive the
+	// orders their owner may read.
+	Permission *policy.Permission
 }
 
 type Config struct {
-	Car   car.Conf
-	Order order.Conf
+	Car     car.Conf
+	Order   order.Conf
+	Outbox  outbox.Conf
+	Webhook webhook.Conf
+	Audit   audit.Conf
 }
 
 type DomainInterface struct {
-	Car   car.CarInterface
-	Order order.OrderInterface
+	Car     car.CarInterface
+	Order   order.OrderInterface
+	Outbox  outbox.OutboxInterface
+	Webhook webhook.WebhookInterface
+	Audit   audit.AuditInterface
 }
 
 func New(d *DomainDep) *DomainInterface {
+	// webhooks and order watchers receive events through the outbox next to
+	// the stream.
+	wh := webhook.New(d.Conf.Webhook, d.Log, d.DB, d.Grpc, d.Permission)
+	publisher := outbox.NewMultiPublisher(
+		outbox.NewRedisStreamPublisher(d.Redis, d.Conf.Outbox.Stream, d.Conf.Outbox.StreamMaxLen),
+		outbox.NewRedisPubSubPublisher(d.Redis, d.Conf.Outbox.Channel),
+		wh,
+	)
+
 	return &DomainInterface{
 		car.New(d.Conf.Car, d.Log, d.DB, d.Redis, d.Grpc),
 		order.New(d.Conf.Order, d.Log, d.DB, d.Redis, d.Grpc),
+		outbox.New(d.Conf.Outbox, d.Log, d.DB, d.Redis, publisher),
+		wh,
+		audit.New(d.Conf.Audit, d.Log, d.DB, d.Grpc),
 	}
 }
@@ -66,6 +66,37 @@ func (mr *MockCarInterfaceMockRecorder) DeleteGRPC(ctx, v interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGRPC", reflect.TypeOf((*MockCarInterface)(nil).DeleteGRPC), ctx, v)
 }
 
+// GetAvailable mocks base method.
+func (m *MockCarInterface) GetAvailable(ctx *context.Context, param *model.GetAvailableCarsByParam) (psqlmodel.CarSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", ctx, param)
+	ret0, _ := ret[0].(psqlmodel.CarSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAvailable indicates an expected call of GetAvailable.
+func (mr *MockCarInterfaceMockRecorder) GetAvailable(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockCarInterface)(nil).GetAvailable), ctx, param)
+}
+
+// GetAvailableCars mocks base method.
+func (m *MockCarInterface) GetAvailableCars(ctx context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableCars", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetCarByParamReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableCars indicates an expected call of GetAvailableCars.
+func (mr *MockCarInterfaceMockRecorder) GetAvailableCars(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableCars", reflect.TypeOf((*MockCarInterface)(nil).GetAvailableCars), ctx, v)
+}
+
 // GetByIDGRPC mocks base method.
 func (m *MockCarInterface) GetByIDGRPC(ctx context.Context, v *grpcmodel.GetCarByIDRequest) (*grpcmodel.SingleCarReply, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +187,50 @@ func (mr *MockCarInterfaceMockRecorder) InsertGRPC(ctx, v interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGRPC", reflect.TypeOf((*MockCarInterface)(nil).InsertGRPC), ctx, v)
 }
 
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockCarInterface) ReleaseIdempotencyKey(ctx *context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockCarInterfaceMockRecorder) ReleaseIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockCarInterface)(nil).ReleaseIdempotencyKey), ctx, key)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockCarInterface) ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, key, record)
+	ret0, _ := ret[0].(model.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockCarInterfaceMockRecorder) ReserveIdempotencyKey(ctx, key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockCarInterface)(nil).ReserveIdempotencyKey), ctx, key, record)
+}
+
+// SaveIdempotencyKey mocks base method.
+func (m *MockCarInterface) SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIdempotencyKey", ctx, key, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIdempotencyKey indicates an expected call of SaveIdempotencyKey.
+func (mr *MockCarInterfaceMockRecorder) SaveIdempotencyKey(ctx, key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotencyKey", reflect.TypeOf((*MockCarInterface)(nil).SaveIdempotencyKey), ctx, key, record)
+}
+
 // Update mocks base method.
 func (m *MockCarInterface) Update(ctx *context.Context, v *psqlmodel.Car) error {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mock_order
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/achwanyusuf/carrent-ordersvc/src/model"
 	grpcmodel "github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
@@ -37,6 +38,20 @@ func (m *MockOrderInterface) EXPECT() *MockOrderInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CheckAvailability mocks base method.
+func (m *MockOrderInterface) CheckAvailability(ctx *context.Context, v *psqlmodel.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAvailability", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAvailability indicates an expected call of CheckAvailability.
+func (mr *MockOrderInterfaceMockRecorder) CheckAvailability(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAvailability", reflect.TypeOf((*MockOrderInterface)(nil).CheckAvailability), ctx, v)
+}
+
 // Delete mocks base method.
 func (m *MockOrderInterface) Delete(ctx *context.Context, v *psqlmodel.Order, id int64, isHardDelete bool) error {
 	m.ctrl.T.Helper()
@@ -66,6 +81,35 @@ func (mr *MockOrderInterfaceMockRecorder) DeleteGRPC(ctx, v interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGRPC", reflect.TypeOf((*MockOrderInterface)(nil).DeleteGRPC), ctx, v)
 }
 
+// Extend mocks base method.
+func (m *MockOrderInterface) Extend(ctx *context.Context, v *psqlmodel.Order, from time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, v, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockOrderInterfaceMockRecorder) Extend(ctx, v, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockOrderInterface)(nil).Extend), ctx, v, from)
+}
+
+// ExtendGRPC mocks base method.
+func (m *MockOrderInterface) ExtendGRPC(ctx context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.ExtendOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendGRPC indicates an expected call of ExtendGRPC.
+func (mr *MockOrderInterfaceMockRecorder) ExtendGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendGRPC", reflect.TypeOf((*MockOrderInterface)(nil).ExtendGRPC), ctx, v)
+}
+
 // GetByIDGRPC mocks base method.
 func (m *MockOrderInterface) GetByIDGRPC(ctx context.Context, v *grpcmodel.GetOrderByIDRequest) (*grpcmodel.SingleOrderReply, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +141,21 @@ func (mr *MockOrderInterfaceMockRecorder) GetByParam(ctx, cacheControl, param in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockOrderInterface)(nil).GetByParam), ctx, cacheControl, param)
 }
 
+// GetLate mocks base method.
+func (m *MockOrderInterface) GetLate(ctx *context.Context, now time.Time) (psqlmodel.OrderSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLate", ctx, now)
+	ret0, _ := ret[0].(psqlmodel.OrderSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLate indicates an expected call of GetLate.
+func (mr *MockOrderInterfaceMockRecorder) GetLate(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLate", reflect.TypeOf((*MockOrderInterface)(nil).GetLate), ctx, now)
+}
+
 // GetOrderByParam mocks base method.
 func (m *MockOrderInterface) GetOrderByParam(ctx context.Context, v *grpcmodel.GetOrderByParamRequest) (*grpcmodel.GetOrderByParamReply, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +186,36 @@ func (mr *MockOrderInterfaceMockRecorder) GetSingleByParam(ctx, cacheControl, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockOrderInterface)(nil).GetSingleByParam), ctx, cacheControl, param)
 }
 
+// GetStatusHistory mocks base method.
+func (m *MockOrderInterface) GetStatusHistory(ctx *context.Context, orderID int64) (psqlmodel.OrderStatusHistorySlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, orderID)
+	ret0, _ := ret[0].(psqlmodel.OrderStatusHistorySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockOrderInterfaceMockRecorder) GetStatusHistory(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockOrderInterface)(nil).GetStatusHistory), ctx, orderID)
+}
+
+// GetStatusHistoryGRPC mocks base method.
+func (m *MockOrderInterface) GetStatusHistoryGRPC(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistoryGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetOrderStatusHistoryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistoryGRPC indicates an expected call of GetStatusHistoryGRPC.
+func (mr *MockOrderInterfaceMockRecorder) GetStatusHistoryGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistoryGRPC", reflect.TypeOf((*MockOrderInterface)(nil).GetStatusHistoryGRPC), ctx, v)
+}
+
 // Insert mocks base method.
 func (m *MockOrderInterface) Insert(ctx *context.Context, data *psqlmodel.Order) error {
 	m.ctrl.T.Helper()
@@ -156,6 +245,65 @@ func (mr *MockOrderInterfaceMockRecorder) InsertGRPC(ctx, v interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGRPC", reflect.TypeOf((*MockOrderInterface)(nil).InsertGRPC), ctx, v)
 }
 
+// QuoteGRPC mocks base method.
+func (m *MockOrderInterface) QuoteGRPC(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.QuoteOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteGRPC indicates an expected call of QuoteGRPC.
+func (mr *MockOrderInterfaceMockRecorder) QuoteGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteGRPC", reflect.TypeOf((*MockOrderInterface)(nil).QuoteGRPC), ctx, v)
+}
+
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockOrderInterface) ReleaseIdempotencyKey(ctx *context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockOrderInterfaceMockRecorder) ReleaseIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockOrderInterface)(nil).ReleaseIdempotencyKey), ctx, key)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockOrderInterface) ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, key, record)
+	ret0, _ := ret[0].(model.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockOrderInterfaceMockRecorder) ReserveIdempotencyKey(ctx, key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockOrderInterface)(nil).ReserveIdempotencyKey), ctx, key, record)
+}
+
+// SaveIdempotencyKey mocks base method.
+func (m *MockOrderInterface) SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIdempotencyKey", ctx, key, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIdempotencyKey indicates an expected call of SaveIdempotencyKey.
+func (mr *MockOrderInterfaceMockRecorder) SaveIdempotencyKey(ctx, key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotencyKey", reflect.TypeOf((*MockOrderInterface)(nil).SaveIdempotencyKey), ctx, key, record)
+}
+
 // Update mocks base method.
 func (m *MockOrderInterface) Update(ctx *context.Context, v *psqlmodel.Order) error {
 	m.ctrl.T.Helper()
@@ -184,3 +332,60 @@ func (mr *MockOrderInterfaceMockRecorder) UpdateGRPC(ctx, v interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGRPC", reflect.TypeOf((*MockOrderInterface)(nil).UpdateGRPC), ctx, v)
 }
+
+// UpdateLateFee mocks base method.
+func (m *MockOrderInterface) UpdateLateFee(ctx *context.Context, v *psqlmodel.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLateFee", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLateFee indicates an expected call of UpdateLateFee.
+func (mr *MockOrderInterfaceMockRecorder) UpdateLateFee(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLateFee", reflect.TypeOf((*MockOrderInterface)(nil).UpdateLateFee), ctx, v)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockOrderInterface) UpdateStatus(ctx *context.Context, v *psqlmodel.Order, from string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, v, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockOrderInterfaceMockRecorder) UpdateStatus(ctx, v, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderInterface)(nil).UpdateStatus), ctx, v, from)
+}
+
+// UpdateStatusGRPC mocks base method.
+func (m *MockOrderInterface) UpdateStatusGRPC(ctx context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusGRPC", ctx, status, v)
+	ret0, _ := ret[0].(*grpcmodel.SingleOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusGRPC indicates an expected call of UpdateStatusGRPC.
+func (mr *MockOrderInterfaceMockRecorder) UpdateStatusGRPC(ctx, status, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusGRPC", reflect.TypeOf((*MockOrderInterface)(nil).UpdateStatusGRPC), ctx, status, v)
+}
+
+// WatchGRPC mocks base method.
+func (m *MockOrderInterface) WatchGRPC(ctx context.Context, v *grpcmodel.GetOrderByParamRequest, fn func(*grpcmodel.OrderEventReply) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchGRPC", ctx, v, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchGRPC indicates an expected call of WatchGRPC.
+func (mr *MockOrderInterfaceMockRecorder) WatchGRPC(ctx, v, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchGRPC", reflect.TypeOf((*MockOrderInterface)(nil).WatchGRPC), ctx, v, fn)
+}
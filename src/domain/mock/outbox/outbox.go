@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/outbox/outbox.go
+
+// Package mock_outbox is a generated GoMock package.
+package mock_outbox
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/achwanyusuf/carrent-ordersvc/src/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOutboxInterface is a mock of OutboxInterface interface.
+type MockOutboxInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxInterfaceMockRecorder
+}
+
+// MockOutboxInterfaceMockRecorder is the mock recorder for MockOutboxInterface.
+type MockOutboxInterfaceMockRecorder struct {
+	mock *MockOutboxInterface
+}
+
+// NewMockOutboxInterface creates a new mock instance.
+func NewMockOutboxInterface(ctrl *gomock.Controller) *MockOutboxInterface {
+	mock := &MockOutboxInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxInterface) EXPECT() *MockOutboxInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Relay mocks base method.
+func (m *MockOutboxInterface) Relay(ctx *context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockOutboxInterfaceMockRecorder) Relay(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockOutboxInterface)(nil).Relay), ctx, now)
+}
+
+// Subscribe mocks base method.
+func (m *MockOutboxInterface) Subscribe(ctx context.Context, afterID int64, fn func(model.OutboxEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, afterID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockOutboxInterfaceMockRecorder) Subscribe(ctx, afterID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockOutboxInterface)(nil).Subscribe), ctx, afterID, fn)
+}
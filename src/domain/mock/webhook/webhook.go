@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/webhook/webhook.go
+
+// Package mock_webhook is a generated GoMock package.
+package mock_webhook
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/achwanyusuf/carrent-ordersvc/src/model"
+	grpcmodel "github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	psqlmodel "github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookInterface is a mock of WebhookInterface interface.
+type MockWebhookInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInterfaceMockRecorder
+}
+
+// MockWebhookInterfaceMockRecorder is the mock recorder for MockWebhookInterface.
+type MockWebhookInterfaceMockRecorder struct {
+	mock *MockWebhookInterface
+}
+
+// NewMockWebhookInterface creates a new mock instance.
+func NewMockWebhookInterface(ctrl *gomock.Controller) *MockWebhookInterface {
+	mock := &MockWebhookInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookInterface) EXPECT() *MockWebhookInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockWebhookInterface) Delete(ctx *context.Context, v *psqlmodel.Webhook, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, v, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookInterfaceMockRecorder) Delete(ctx, v, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookInterface)(nil).Delete), ctx, v, id)
+}
+
+// DeleteGRPC mocks base method.
+func (m *MockWebhookInterface) DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.DeleteWebhookReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGRPC indicates an expected call of DeleteGRPC.
+func (mr *MockWebhookInterfaceMockRecorder) DeleteGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGRPC", reflect.TypeOf((*MockWebhookInterface)(nil).DeleteGRPC), ctx, v)
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookInterface) Deliver(ctx *context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookInterfaceMockRecorder) Deliver(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookInterface)(nil).Deliver), ctx, now)
+}
+
+// GetByOwner mocks base method.
+func (m *MockWebhookInterface) GetByOwner(ctx *context.Context, ownerID int64) (psqlmodel.WebhookSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwner", ctx, ownerID)
+	ret0, _ := ret[0].(psqlmodel.WebhookSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwner indicates an expected call of GetByOwner.
+func (mr *MockWebhookInterfaceMockRecorder) GetByOwner(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockWebhookInterface)(nil).GetByOwner), ctx, ownerID)
+}
+
+// GetByOwnerGRPC mocks base method.
+func (m *MockWebhookInterface) GetByOwnerGRPC(ctx context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwnerGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetWebhooksReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwnerGRPC indicates an expected call of GetByOwnerGRPC.
+func (mr *MockWebhookInterfaceMockRecorder) GetByOwnerGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerGRPC", reflect.TypeOf((*MockWebhookInterface)(nil).GetByOwnerGRPC), ctx, v)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookInterface) GetDeliveries(ctx *context.Context, param *model.GetWebhookDeliveriesByParam) (psqlmodel.WebhookDeliverySlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, param)
+	ret0, _ := ret[0].(psqlmodel.WebhookDeliverySlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveries(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveries), ctx, param)
+}
+
+// GetDeliveriesGRPC mocks base method.
+func (m *MockWebhookInterface) GetDeliveriesGRPC(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveriesGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetWebhookDeliveriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveriesGRPC indicates an expected call of GetDeliveriesGRPC.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveriesGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveriesGRPC", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveriesGRPC), ctx, v)
+}
+
+// GetSingleByOwner mocks base method.
+func (m *MockWebhookInterface) GetSingleByOwner(ctx *context.Context, id, ownerID int64) (psqlmodel.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByOwner", ctx, id, ownerID)
+	ret0, _ := ret[0].(psqlmodel.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByOwner indicates an expected call of GetSingleByOwner.
+func (mr *MockWebhookInterfaceMockRecorder) GetSingleByOwner(ctx, id, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByOwner", reflect.TypeOf((*MockWebhookInterface)(nil).GetSingleByOwner), ctx, id, ownerID)
+}
+
+// GetSingleDelivery mocks base method.
+func (m *MockWebhookInterface) GetSingleDelivery(ctx *context.Context, webhookID, id int64) (psqlmodel.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleDelivery", ctx, webhookID, id)
+	ret0, _ := ret[0].(psqlmodel.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleDelivery indicates an expected call of GetSingleDelivery.
+func (mr *MockWebhookInterfaceMockRecorder) GetSingleDelivery(ctx, webhookID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleDelivery", reflect.TypeOf((*MockWebhookInterface)(nil).GetSingleDelivery), ctx, webhookID, id)
+}
+
+// Insert mocks base method.
+func (m *MockWebhookInterface) Insert(ctx *context.Context, v *psqlmodel.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWebhookInterfaceMockRecorder) Insert(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebhookInterface)(nil).Insert), ctx, v)
+}
+
+// InsertGRPC mocks base method.
+func (m *MockWebhookInterface) InsertGRPC(ctx context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.SingleWebhookReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertGRPC indicates an expected call of InsertGRPC.
+func (mr *MockWebhookInterfaceMockRecorder) InsertGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGRPC", reflect.TypeOf((*MockWebhookInterface)(nil).InsertGRPC), ctx, v)
+}
+
+// Publish mocks base method.
+func (m *MockWebhookInterface) Publish(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockWebhookInterfaceMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockWebhookInterface)(nil).Publish), ctx, event)
+}
+
+// Replay mocks base method.
+func (m *MockWebhookInterface) Replay(ctx *context.Context, v *psqlmodel.WebhookDelivery, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, v, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockWebhookInterfaceMockRecorder) Replay(ctx, v, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockWebhookInterface)(nil).Replay), ctx, v, now)
+}
+
+// ReplayGRPC mocks base method.
+func (m *MockWebhookInterface) ReplayGRPC(ctx context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayGRPC", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.WebhookDeliveryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayGRPC indicates an expected call of ReplayGRPC.
+func (mr *MockWebhookInterfaceMockRecorder) ReplayGRPC(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayGRPC", reflect.TypeOf((*MockWebhookInterface)(nil).ReplayGRPC), ctx, v)
+}
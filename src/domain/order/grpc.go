@@ -2,9 +2,11 @@ package order
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
 )
@@ -17,11 +19,11 @@ func (o *OrderDep) InsertGRPC(ctx context.Context, v *grpcmodel.CreateOrderReque
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.CreateOrder(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -36,11 +38,11 @@ func (o *OrderDep) UpdateGRPC(ctx context.Context, v *grpcmodel.UpdateOrderReque
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.UpdateOrder(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -55,11 +57,11 @@ func (o *OrderDep) DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteOrderReque
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.DeleteOrder(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -74,11 +76,11 @@ func (o *OrderDep) GetByIDGRPC(ctx context.Context, v *grpcmodel.GetOrderByIDReq
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.GetOrderByID(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
@@ -93,13 +95,144 @@ func (o *OrderDep) GetOrderByParam(ctx context.Context, v *grpcmodel.GetOrderByP
 
 	clientService := grpcmodel.NewOrderClient(client.Conn)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
 	defer cancel()
 	res, err := clientService.GetOrderByParam(ctx, v)
 	if err != nil {
-		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client")
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
 	}
 
 	client.Release()
 	return res, nil
 }
+
+func (o *OrderDep) QuoteGRPC(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error) {
+	client, err := o.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.QuoteOrder(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (o *OrderDep) UpdateStatusGRPC(ctx context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	client, err := o.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+
+	var res *grpcmodel.SingleOrderReply
+	switch status {
+	case model.OrderStatusConfirmed:
+		res, err = clientService.ConfirmOrder(ctx, v)
+	case model.OrderStatusPickedUp:
+		res, err = clientService.PickupOrder(ctx, v)
+	case model.OrderStatusReturned:
+		res, err = clientService.ReturnOrder(ctx, v)
+	case model.OrderStatusCompleted:
+		res, err = clientService.CompleteOrder(ctx, v)
+	case model.OrderStatusCancelled:
+		res, err = clientService.CancelOrder(ctx, v)
+	default:
+		client.Release()
+		return nil, errormsg.WrapErr(svcerr.OrderSVCInvalidTransition, nil, "unknown order status "+status)
+	}
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (o *OrderDep) ExtendGRPC(ctx context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error) {
+	client, err := o.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.ExtendOrder(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (o *OrderDep) GetStatusHistoryGRPC(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error) {
+	client, err := o.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.GetOrderStatusHistory(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+// WatchGRPC calls fn with every event of the WatchOrders stream until ctx is
+// done, the stream ends or fn returns an error. The stream has no deadline, it
+// lives as long as ctx.
+func (o *OrderDep) WatchGRPC(ctx context.Context, v *grpcmodel.GetOrderByParamRequest, fn func(*grpcmodel.OrderEventReply) error) error {
+	client, err := o.Grpc.Get()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+	defer client.Release()
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithCancel(model.NewOutgoingContext(ctx))
+	defer cancel()
+	stream, err := clientService.WatchOrders(ctx, v)
+	if err != nil {
+		return svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return svcerr.FromGRPCStatus(err, "error grpc client")
+		}
+
+		err = fn(res)
+		if err != nil {
+			return err
+		}
+	}
+}
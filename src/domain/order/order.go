@@ -16,6 +16,7 @@ import (
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
 
 	goredislib "github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 )
 
 type OrderDep struct {
@@ -24,26 +25,51 @@ type OrderDep struct {
 	Redis *goredislib.Client
 	Conf  Conf
 	Grpc  *grpcclientpool.CPool
+
+	flight singleflight.Group
 }
 
 type Conf struct {
 	DefaultPageLimit    int           `mapstructure:"page_limit"`
 	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
+	// SingleFlight coalesces identical list cache misses into one psql read.
+	SingleFlight bool `mapstructure:"single_flight"`
+	// StaleWhileRevalidate keeps list entries this long past their expiration,
+	// a stale entry is served while it is refreshed in the background.
+	StaleWhileRevalidate time.Duration `mapstructure:"stale_while_revalidate"`
+	// FlightTimeout bounds a shared list read, it does not end with the
+	// caller that started it.
+	FlightTimeout time.Duration `mapstructure:"flight_timeout"`
 }
 
 type OrderInterface interface {
 	Insert(ctx *context.Context, data *psqlmodel.Order) error
 	GetSingleByParam(ctx *context.Context, cacheControl string, param *model.GetOrderByParam) (psqlmodel.Order, error)
 	Update(ctx *context.Context, v *psqlmodel.Order) error
+	UpdateStatus(ctx *context.Context, v *psqlmodel.Order, from string) error
+	Extend(ctx *context.Context, v *psqlmodel.Order, from time.Time) error
+	GetLate(ctx *context.Context, now time.Time) (psqlmodel.OrderSlice, error)
+	UpdateLateFee(ctx *context.Context, v *psqlmodel.Order) error
+	GetStatusHistory(ctx *context.Context, orderID int64) (psqlmodel.OrderStatusHistorySlice, error)
 	Delete(ctx *context.Context, v *psqlmodel.Order, id int64, isHardDelete bool) error
 	GetByParam(ctx *context.Context, cacheControl string, param *model.GetOrdersByParam) (psqlmodel.OrderSlice, model.Pagination, error)
+	CheckAvailability(ctx *context.Context, v *psqlmodel.Order) error
+
+	ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error)
+	SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error
+	ReleaseIdempotencyKey(ctx *context.Context, key string) error
 
 	// grpc client
 	InsertGRPC(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.SingleOrderReply, error)
 	GetByIDGRPC(ctx context.Context, v *grpcmodel.GetOrderByIDRequest) (*grpcmodel.SingleOrderReply, error)
 	GetOrderByParam(ctx context.Context, v *grpcmodel.GetOrderByParamRequest) (*grpcmodel.GetOrderByParamReply, error)
+	WatchGRPC(ctx context.Context, v *grpcmodel.GetOrderByParamRequest, fn func(*grpcmodel.OrderEventReply) error) error
 	DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteOrderRequest) (*grpcmodel.DeleteOrderReply, error)
 	UpdateGRPC(ctx context.Context, v *grpcmodel.UpdateOrderRequest) (*grpcmodel.SingleOrderReply, error)
+	QuoteGRPC(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error)
+	UpdateStatusGRPC(ctx context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	ExtendGRPC(ctx context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error)
+	GetStatusHistoryGRPC(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error)
 }
 
 func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client, grpc *grpcclientpool.CPool) OrderInterface {
@@ -57,7 +83,58 @@ func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client, grpc
 }
 
 func (o *OrderDep) Insert(ctx *context.Context, data *psqlmodel.Order) error {
-	return o.insertPSQL(ctx, data)
+	err := o.insertPSQL(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
+}
+
+// ReserveIdempotencyKey stores record under key unless the key already exists,
+// in which case the stored record is returned and reserved is false.
+func (o *OrderDep) ReserveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	reserved, err := o.reserveIdempotencyRedis(ctx, key, record)
+	if err != nil {
+		return record, false, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error reserve idempotency key")
+	}
+
+	if reserved {
+		return record, true, nil
+	}
+
+	stored, err := o.getIdempotencyRedis(ctx, key)
+	if err != nil {
+		return stored, false, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get idempotency key")
+	}
+
+	return stored, false, nil
+}
+
+func (o *OrderDep) SaveIdempotencyKey(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	err := o.setIdempotencyRedis(ctx, key, record)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error save idempotency key")
+	}
+
+	return nil
+}
+
+func (o *OrderDep) ReleaseIdempotencyKey(ctx *context.Context, key string) error {
+	_, err := o.Redis.Del(*ctx, key).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error release idempotency key")
+	}
+
+	return nil
+}
+
+// CheckAvailability reports whether the car of the order is free within its
+// period without locking anything, the result is only a preview and Insert
+// checks it again.
+func (o *OrderDep) CheckAvailability(ctx *context.Context, v *psqlmodel.Order) error {
+	return o.checkOverlapPSQL(ctx, o.DB, v)
 }
 
 func (o *OrderDep) GetSingleByParam(ctx *context.Context, cacheControl string, param *model.GetOrderByParam) (psqlmodel.Order, error) {
@@ -66,7 +143,13 @@ func (o *OrderDep) GetSingleByParam(ctx *context.Context, cacheControl string, p
 		return psqlmodel.Order{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 	}
 
-	key := fmt.Sprintf(model.GetSingleByParamOrderKey, str)
+	ns, err := o.getCacheNamespaceRedis(ctx)
+	if err != nil {
+		o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache namespace"))
+		return o.getSingleByParamPSQL(ctx, param)
+	}
+
+	key := fmt.Sprintf(model.GetSingleByParamOrderKey, ns, str)
 	if cacheControl != model.MustRevalidate {
 		res, err := o.getSingleByParamRedis(ctx, key)
 		if err != nil {
@@ -104,72 +187,203 @@ func (o *OrderDep) GetSingleByParam(ctx *context.Context, cacheControl string, p
 }
 
 func (o *OrderDep) Update(ctx *context.Context, v *psqlmodel.Order) error {
-	return o.updatePSQL(ctx, v)
+	err := o.updatePSQL(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
+}
+
+// UpdateStatus stores the order with its new status, provided the stored order
+// is still in status from.
+func (o *OrderDep) UpdateStatus(ctx *context.Context, v *psqlmodel.Order, from string) error {
+	err := o.updateStatusPSQL(ctx, v, from)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
+}
+
+// Extend stores the order with its new dropoff date, provided the stored
+// order still ends on from and the car is free for the added period.
+func (o *OrderDep) Extend(ctx *context.Context, v *psqlmodel.Order, from time.Time) error {
+	err := o.extendPSQL(ctx, v, from)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
+}
+
+// GetLate returns picked up orders whose dropoff date has passed at time now.
+func (o *OrderDep) GetLate(ctx *context.Context, now time.Time) (psqlmodel.OrderSlice, error) {
+	return o.getLatePSQL(ctx, now)
+}
+
+// UpdateLateFee stores the overdue flag and late fee of the order, provided the
+// car has not been returned in the meantime.
+func (o *OrderDep) UpdateLateFee(ctx *context.Context, v *psqlmodel.Order) error {
+	err := o.updateLateFeePSQL(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
+}
+
+func (o *OrderDep) GetStatusHistory(ctx *context.Context, orderID int64) (psqlmodel.OrderStatusHistorySlice, error) {
+	return o.getStatusHistoryPSQL(ctx, orderID)
 }
 
 func (o *OrderDep) Delete(ctx *context.Context, v *psqlmodel.Order, id int64, isHardDelete bool) error {
-	return o.deletePSQL(ctx, v, id, isHardDelete)
+	err := o.deletePSQL(ctx, v, id, isHardDelete)
+	if err != nil {
+		return err
+	}
+
+	o.invalidateCache(ctx)
+	return nil
 }
+
+// GetByParam returns the orders of param, with their car when param includes
+// it. The cars are not cached and are loaded for the whole page at once.
 func (o *OrderDep) GetByParam(ctx *context.Context, cacheControl string, param *model.GetOrdersByParam) (psqlmodel.OrderSlice, model.Pagination, error) {
+	res, pg, err := o.getByParam(ctx, cacheControl, param)
+	if err != nil || !param.HasInclude(model.OrderIncludeCar) {
+		return res, pg, err
+	}
+
+	res, err = o.loadCarPSQL(ctx, res)
+	return res, pg, err
+}
+
+func (o *OrderDep) getByParam(ctx *context.Context, cacheControl string, param *model.GetOrdersByParam) (psqlmodel.OrderSlice, model.Pagination, error) {
 	var pg model.Pagination
-	var res psqlmodel.OrderSlice
 
 	str, err := json.Marshal(param)
 	if err != nil {
 		return psqlmodel.OrderSlice{}, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 	}
 
-	key := fmt.Sprintf(model.GetByParamOrderKey, str)
-	keyPg := fmt.Sprintf(model.GetByParamOrderPgKey, str)
+	ns, err := o.getCacheNamespaceRedis(ctx)
+	if err != nil {
+		o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache namespace"))
+		return o.getByParamPSQL(ctx, param)
+	}
+
+	key := fmt.Sprintf(model.GetByParamOrderKey, ns, str)
+	keyPg := fmt.Sprintf(model.GetByParamOrderPgKey, ns, str)
 	if cacheControl != model.MustRevalidate {
 		res, err1 := o.getByParamRedis(ctx, key)
 		pg, err2 := o.getByParamPaginationRedis(ctx, keyPg)
 		if err1 != nil || err2 != nil {
 			if err1 == goredislib.Nil || err2 == goredislib.Nil {
-				res, pg, err := o.getByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-					}
-					err = o.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-					}
-					dataStr, err = json.Marshal(&pg)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-					}
-					err = o.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-					}
+				if o.Conf.SingleFlight {
+					return o.flightByParam(ctx, param, key, keyPg)
 				}
-				return res, pg, err
+				return o.loadByParam(ctx, param, key, keyPg)
 			}
 			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal param")
 		}
+
+		if o.Conf.StaleWhileRevalidate > 0 {
+			stale, err := o.isStaleRedis(ctx, key)
+			if err != nil {
+				o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get cache ttl"))
+			}
+			if stale {
+				o.revalidateByParam(param, key, keyPg)
+			}
+		}
 		return res, pg, nil
 	}
 
-	res, pg, err = o.getByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
-		}
-		err = o.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
-		}
-		dataStr, err = json.Marshal(&pg)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	return o.loadByParam(ctx, param, key, keyPg)
+}
+
+// loadByParam reads the list from psql and caches it with its pagination.
+func (o *OrderDep) loadByParam(ctx *context.Context, param *model.GetOrdersByParam, key, keyPg string) (psqlmodel.OrderSlice, model.Pagination, error) {
+	res, pg, err := o.getByParamPSQL(ctx, param)
+	if err != nil {
+		return res, pg, err
+	}
+
+	dataStr, err := json.Marshal(&res)
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	}
+	err = o.setByParamRedis(ctx, key, string(dataStr))
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+	}
+	dataStr, err = json.Marshal(&pg)
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get psql")
+	}
+	err = o.setByParamRedis(ctx, keyPg, string(dataStr))
+	if err != nil {
+		return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+	}
+	return res, pg, nil
+}
+
+type byParamResult struct {
+	res psqlmodel.OrderSlice
+	pg  model.Pagination
+}
+
+// flightByParam is loadByParam shared by every concurrent call for the same
+// key, so a popular entry expiring costs a single psql read. The read runs on
+// its own context so a caller going away does not fail the others, and each
+// caller stops waiting once its own ctx is done.
+func (o *OrderDep) flightByParam(ctx *context.Context, param *model.GetOrdersByParam, key, keyPg string) (psqlmodel.OrderSlice, model.Pagination, error) {
+	p := *param
+	ch := o.flight.DoChan(key, func() (interface{}, error) {
+		flightTimeout := o.Conf.FlightTimeout
+		if flightTimeout == 0 {
+			flightTimeout = model.DefaultFlightTimeout
 		}
-		err = o.setRedis(ctx, key, string(dataStr))
+		flightCtx, cancel := context.WithTimeout(context.Background(), flightTimeout)
+		defer cancel()
+		res, pg, err := o.loadByParam(&flightCtx, &p, key, keyPg)
+		return byParamResult{res: res, pg: pg}, err
+	})
+
+	select {
+	case r := <-ch:
+		result := r.Val.(byParamResult)
+		return result.res, result.pg, r.Err
+	case <-(*ctx).Done():
+		return psqlmodel.OrderSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, (*ctx).Err(), "error wait psql")
+	}
+}
+
+// revalidateByParam refreshes a stale list entry in the background while the
+// stale value is served.
+func (o *OrderDep) revalidateByParam(param *model.GetOrdersByParam, key, keyPg string) {
+	p := *param
+	go func() {
+		ctx := context.Background()
+		_, _, err := o.flightByParam(&ctx, &p, key, keyPg)
 		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error set redis")
+			o.Log.Warn(ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error revalidate cache"))
 		}
+	}()
+}
+
+// invalidateCache bumps the order cache namespace so every cached single and
+// list entry is bypassed, it is called after each write. The write has already
+// succeeded so a failure is only logged, stale entries then live until they
+// expire.
+func (o *OrderDep) invalidateCache(ctx *context.Context) {
+	err := o.bumpCacheNamespaceRedis(ctx)
+	if err != nil {
+		o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error invalidate cache"))
 	}
-	return res, pg, err
 }
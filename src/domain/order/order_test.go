@@ -0,0 +1,372 @@
+package order_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/order"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+
+	gosqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v9"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestCacheNamespace(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := order.OrderDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: order.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	data := psqlmodel.Order{
+		ID:        1,
+		CarID:     2,
+		Status:    model.OrderStatusPending,
+		CreatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+		Version:   1,
+	}
+	singleParam := &model.GetOrderByParam{
+		ID: null.NewInt64(1, true),
+	}
+	singleStr, _ := json.Marshal(singleParam)
+	dataStr, _ := json.Marshal(&data)
+
+	Convey("test cache namespace", t, FailureHalts, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			mockFunc func()
+			call     func() (psqlmodel.Order, error)
+			wantCode int64
+		}{
+			{
+				testType: "P",
+				testDesc: "test get single read from current namespace",
+				mockFunc: func() {
+					redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetVal("5")
+					redisMock.ExpectGet(fmt.Sprintf(model.GetSingleByParamOrderKey, 5, singleStr)).SetVal(string(dataStr))
+				},
+				call: func() (psqlmodel.Order, error) {
+					return acc.GetSingleByParam(&ctx, "", singleParam)
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test delete bump namespace",
+				mockFunc: func() {
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM \"orders\"")).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"outbox\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "attempts", "last_error", "available_at", "published_at"}).AddRow(1, 0, nil, time.Now(), nil))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"audit_log\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "request_id"}).AddRow(1, nil))
+					sqlMock.ExpectCommit()
+					redisMock.ExpectIncr(model.OrderCacheNamespaceKey).SetVal(6)
+				},
+				call: func() (psqlmodel.Order, error) {
+					v := data
+					err := acc.Delete(&ctx, &v, 1, true)
+					return v, err
+				},
+			},
+			{
+				testType: "N",
+				testDesc: "test failed delete keep namespace",
+				mockFunc: func() {
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM \"orders\"")).WillReturnError(errors.New("connection reset"))
+					sqlMock.ExpectRollback()
+				},
+				call: func() (psqlmodel.Order, error) {
+					v := data
+					err := acc.Delete(&ctx, &v, 1, true)
+					return v, err
+				},
+				wantCode: svcerr.CodePSQLErrorUpdate,
+			},
+			{
+				testType: "P",
+				testDesc: "test get single bypass cache when namespace unavailable",
+				mockFunc: func() {
+					redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetErr(errors.New("connection refused"))
+					sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"orders\".* FROM \"orders\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_id", "status", "created_at", "updated_at", "version"}).AddRow(data.ID, data.CarID, data.Status, data.CreatedAt, data.UpdatedAt, data.Version))
+				},
+				call: func() (psqlmodel.Order, error) {
+					return acc.GetSingleByParam(&ctx, "", singleParam)
+				},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				test.mockFunc()
+				res, err := test.call()
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+				} else {
+					So(err, ShouldBeNil)
+					So(res.ID, ShouldEqual, data.ID)
+					So(res.CarID, ShouldEqual, data.CarID)
+					So(res.Status, ShouldEqual, data.Status)
+				}
+				So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
+
+func TestGetByParamCache(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := order.OrderDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: order.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	Convey("test get by param cache", t, FailureHalts, func() {
+		Convey("0 - [P] : test must revalidate cache list and pagination under their own keys", func() {
+			param := &model.GetOrdersByParam{
+				GetOrderByParam: model.GetOrderByParam{
+					CarID: null.Int64From(2),
+				},
+			}
+			str, _ := json.Marshal(param)
+			key := fmt.Sprintf(model.GetByParamOrderKey, 7, str)
+			keyPg := fmt.Sprintf(model.GetByParamOrderPgKey, 7, str)
+			data := psqlmodel.OrderSlice{
+				{
+					ID:        1,
+					CarID:     2,
+					Status:    model.OrderStatusPending,
+					CreatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2022, 2, 22, 2, 0, 0, 0, time.UTC),
+				},
+			}
+			pg := model.Pagination{
+				CurrentPage:     1,
+				CurrentElements: 1,
+				TotalPages:      1,
+				TotalElements:   1,
+			}
+			dataStr, _ := json.Marshal(&data)
+			pgStr, _ := json.Marshal(&pg)
+
+			redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetVal("7")
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM \"orders\"")).WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(1))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT \"orders\".* FROM \"orders\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "car_id", "status", "created_at", "updated_at"}).AddRow(data[0].ID, data[0].CarID, data[0].Status, data[0].CreatedAt, data[0].UpdatedAt))
+			redisMock.ExpectDel(key).SetVal(0)
+			redisMock.ExpectSet(key, string(dataStr), 30*time.Second).SetVal("OK")
+			redisMock.ExpectDel(keyPg).SetVal(0)
+			redisMock.ExpectSet(keyPg, string(pgStr), 30*time.Second).SetVal("OK")
+
+			res, resPg, err := acc.GetByParam(&ctx, model.MustRevalidate, param)
+			So(err, ShouldBeNil)
+			So(len(res), ShouldEqual, 1)
+			So(resPg, ShouldResemble, pg)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestGetByParamCursor(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := order.OrderDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: order.Conf{
+			DefaultPageLimit:    2,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	columns := []string{"id", "car_id", "status"}
+	Convey("test get by param cursor", t, FailureHalts, func() {
+		Convey("0 - [P] : test first page skips the count and reads one row past the limit", func() {
+			param := &model.GetOrdersByParam{PageMode: null.StringFrom(model.PageModeCursor)}
+			redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetErr(errors.New("connection refused"))
+			sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT "orders".* FROM "orders" WHERE ("orders"."deleted_at" is null) ORDER BY id ASC LIMIT 3;`)).WillReturnRows(sqlMock.NewRows(columns).AddRow(1, 2, model.OrderStatusPending).AddRow(2, 2, model.OrderStatusPending).AddRow(3, 2, model.OrderStatusPending))
+
+			res, pg, err := acc.GetByParam(&ctx, "", param)
+			So(err, ShouldBeNil)
+			So(len(res), ShouldEqual, 2)
+			So(pg.TotalElements, ShouldEqual, 0)
+			So(pg.CurrentElements, ShouldEqual, 2)
+			So(pg.NextCursor, ShouldNotBeEmpty)
+			So(pg.PrevCursor, ShouldBeEmpty)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+
+			Convey("1 - [P] : test previous page is put back in order", func() {
+				cursor, _ := model.EncodeCursor(model.Cursor{Values: []interface{}{5}, Prev: true})
+				param := &model.GetOrdersByParam{Cursor: null.StringFrom(cursor)}
+				redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetErr(errors.New("connection refused"))
+				sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT "orders".* FROM "orders" WHERE ((id<$1)) AND ("orders"."deleted_at" is null) ORDER BY id DESC LIMIT 3;`)).WithArgs("5").WillReturnRows(sqlMock.NewRows(columns).AddRow(4, 2, model.OrderStatusPending).AddRow(3, 2, model.OrderStatusPending).AddRow(2, 2, model.OrderStatusPending))
+
+				res, pg, err := acc.GetByParam(&ctx, "", param)
+				So(err, ShouldBeNil)
+				So([]int{res[0].ID, res[1].ID}, ShouldResemble, []int{3, 4})
+				So(pg.NextCursor, ShouldNotBeEmpty)
+				So(pg.PrevCursor, ShouldNotBeEmpty)
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestGetByParamIncludeCar(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := order.OrderDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+		Conf: order.Conf{
+			DefaultPageLimit:    10,
+			RedisExpirationTime: 30 * time.Second,
+		},
+	}
+	ctx := context.Background()
+	anyArg := gosqlmock.AnyArg()
+	Convey("test get by param include car", t, FailureHalts, func() {
+		Convey("0 - [P] : test cars of a cached page loaded by a single query", func() {
+			param := &model.GetOrdersByParam{Include: null.StringFrom(model.OrderIncludeCar)}
+			str, _ := json.Marshal(param)
+			key := fmt.Sprintf(model.GetByParamOrderKey, 7, str)
+			keyPg := fmt.Sprintf(model.GetByParamOrderPgKey, 7, str)
+			cached := psqlmodel.OrderSlice{{ID: 1, CarID: 2}, {ID: 2, CarID: 3}, {ID: 3, CarID: 2}}
+			dataStr, _ := json.Marshal(&cached)
+			pgStr, _ := json.Marshal(&model.Pagination{CurrentPage: 1, CurrentElements: 3, TotalPages: 1, TotalElements: 3})
+
+			redisMock.ExpectGet(model.OrderCacheNamespaceKey).SetVal("7")
+			redisMock.ExpectGet(key).SetVal(string(dataStr))
+			redisMock.ExpectGet(keyPg).SetVal(string(pgStr))
+			sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "cars" WHERE ("cars"."id" IN ($1,$2)) AND (cars.deleted_at is null);`)).WithArgs(anyArg, anyArg).WillReturnRows(sqlMock.NewRows([]string{"id", "car_name"}).AddRow(2, "sedan").AddRow(3, "van"))
+
+			res, _, err := acc.GetByParam(&ctx, "", param)
+			So(err, ShouldBeNil)
+			So(len(res), ShouldEqual, 3)
+			So(res[0].R.Car.CarName, ShouldEqual, "sedan")
+			So(res[1].R.Car.CarName, ShouldEqual, "van")
+			So(res[2].R.Car.CarName, ShouldEqual, "sedan")
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestUpdateLateFee(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	dbRedis, redisMock := redismock.NewClientMock()
+	acc := order.OrderDep{
+		Log:   logger.New(&logger.Config{}),
+		DB:    dbSQL,
+		Redis: dbRedis,
+	}
+	ctx := context.Background()
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	data := &psqlmodel.Order{ID: 3, OverdueAt: null.TimeFrom(now), LateFee: 50}
+	selectQuery := regexp.QuoteMeta(`SELECT "orders".* FROM "orders" WHERE (id=$1) AND (status=$2) AND ("orders"."deleted_at" is null) LIMIT 1 FOR UPDATE;`)
+	anyArg := gosqlmock.AnyArg()
+
+	Convey("test update late fee", t, FailureHalts, func() {
+		Convey("0 - [P] : test late fee recorded with an order updated event", func() {
+			sqlMock.ExpectBegin()
+			sqlMock.ExpectQuery(selectQuery).WithArgs(3, model.OrderStatusPickedUp).WillReturnRows(sqlMock.NewRows([]string{"id", "car_id", "status", "created_by", "version"}).AddRow(3, 2, model.OrderStatusPickedUp, 7, 4))
+			sqlMock.ExpectExec(regexp.QuoteMeta("UPDATE orders SET overdue_at=$1, late_fee=$2, version=version+1 WHERE id=$3")).WithArgs(data.OverdueAt, data.LateFee, 3).WillReturnResult(gosqlmock.NewResult(0, 1))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"audit_log\"")).WillReturnRows(sqlMock.NewRows([]string{"id", "request_id"}).AddRow(1, nil))
+			sqlMock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"outbox\"")).WithArgs(model.OutboxAggregateOrder, 3, model.OrderEventUpdated, anyArg, model.OutboxStatusPending, anyArg).WillReturnRows(sqlMock.NewRows([]string{"id", "attempts", "last_error", "available_at", "published_at"}).AddRow(1, 0, nil, now, nil))
+			sqlMock.ExpectCommit()
+			redisMock.ExpectIncr(model.OrderCacheNamespaceKey).SetVal(6)
+
+			So(acc.UpdateLateFee(&ctx, data), ShouldBeNil)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("1 - [P] : test returned order left as is", func() {
+			sqlMock.ExpectBegin()
+			sqlMock.ExpectQuery(selectQuery).WithArgs(3, model.OrderStatusPickedUp).WillReturnRows(sqlMock.NewRows([]string{"id"}))
+			sqlMock.ExpectRollback()
+			redisMock.ExpectIncr(model.OrderCacheNamespaceKey).SetVal(7)
+
+			So(acc.UpdateLateFee(&ctx, data), ShouldBeNil)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -3,13 +3,17 @@ package order
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -19,13 +23,49 @@ func (o *OrderDep) insertPSQL(ctx *context.Context, data *psqlmodel.Order) error
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
+	err = o.checkAvailabilityPSQL(ctx, tx, data)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = data.Insert(*ctx, tx, boil.Infer())
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
 			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
 		}
+		if isExclusionViolation(err) {
+			return errormsg.WrapErr(svcerr.OrderSVCCarNotAvailable, err, "car is not available")
+		}
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert")
 	}
+
+	err = o.insertStatusHistoryPSQL(ctx, tx, data, null.String{}, data.UpdatedBy)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.OrderEventCreated, data)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionInsert, data.UpdatedBy, nil, data)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -54,13 +94,271 @@ func (o *OrderDep) updatePSQL(ctx *context.Context, order *psqlmodel.Order) erro
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
+	current, err := psqlmodel.Orders(qm.Where("id=?", order.ID), qm.For("UPDATE")).One(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get order")
+	}
+
+	if current.Version != order.Version {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCVersionMismatch, nil, "order has been changed since it was read")
+	}
+	order.Version = current.Version + 1
+
+	if current.CarID != order.CarID || !current.PickupDate.Equal(order.PickupDate) || !current.DropoffDate.Equal(order.DropoffDate) {
+		err = o.checkAvailabilityPSQL(ctx, tx, order)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return err
+		}
+	}
+
+	_, err = order.Update(*ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		if isExclusionViolation(err) {
+			return errormsg.WrapErr(svcerr.OrderSVCCarNotAvailable, err, "car is not available")
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.OrderEventUpdated, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionUpdate, order.UpdatedBy, current, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (o *OrderDep) updateStatusPSQL(ctx *context.Context, order *psqlmodel.Order, from string) error {
+	tx, err := o.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := psqlmodel.Orders(qm.Where("id=?", order.ID), qm.For("UPDATE")).One(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get order")
+	}
+
+	if current.Status != from {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCInvalidTransition, nil, "order status has changed to "+current.Status)
+	}
+
+	if current.Version != order.Version {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCVersionMismatch, nil, "order has been changed since it was read")
+	}
+	order.Version = current.Version + 1
+
+	_, err = order.Update(*ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = o.insertStatusHistoryPSQL(ctx, tx, order, null.StringFrom(from), order.UpdatedBy)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.GetOrderStatusEvent(order.Status), order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionUpdate, order.UpdatedBy, current, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (o *OrderDep) extendPSQL(ctx *context.Context, order *psqlmodel.Order, from time.Time) error {
+	tx, err := o.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := psqlmodel.Orders(qm.Where("id=?", order.ID), qm.For("UPDATE")).One(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get order")
+	}
+
+	if !current.DropoffDate.Equal(from) || current.Status != order.Status {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCOrderNotExtendable, nil, "order has changed since it was read")
+	}
+
+	if current.Version != order.Version {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCVersionMismatch, nil, "order has been changed since it was read")
+	}
+	order.Version = current.Version + 1
+
+	err = o.checkAvailabilityPSQL(ctx, tx, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	_, err = order.Update(*ctx, tx, boil.Infer())
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
 			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
 		}
+		if isExclusionViolation(err) {
+			return errormsg.WrapErr(svcerr.OrderSVCCarNotAvailable, err, "car is not available")
+		}
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
 	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.OrderEventUpdated, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionUpdate, order.UpdatedBy, current, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (o *OrderDep) getLatePSQL(ctx *context.Context, now time.Time) (psqlmodel.OrderSlice, error) {
+	orders, err := psqlmodel.Orders(model.GetLateQuery(now)...).All(*ctx, o.DB)
+	if err != nil {
+		return orders, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get late orders")
+	}
+
+	return orders, nil
+}
+
+func (o *OrderDep) updateLateFeePSQL(ctx *context.Context, order *psqlmodel.Order) error {
+	tx, err := o.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := psqlmodel.Orders(
+		qm.Where("id=?", order.ID),
+		qm.Where("status=?", model.OrderStatusPickedUp),
+		qm.For("UPDATE"),
+	).One(*ctx, tx)
+	if err == sql.ErrNoRows {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return nil
+	}
+
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get order")
+	}
+
+	_, err = queries.Raw(
+		"UPDATE orders SET overdue_at=$1, late_fee=$2, version=version+1 WHERE id=$3",
+		order.OverdueAt, order.LateFee, order.ID,
+	).ExecContext(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update late fee")
+	}
+
+	// the late fee is set by the worker, it is audited as the system
+	updated := *current
+	updated.OverdueAt = order.OverdueAt
+	updated.LateFee = order.LateFee
+	updated.Version = current.Version + 1
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionUpdate, model.AuditActorSystem, current, &updated)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.OrderEventUpdated, &updated)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -68,7 +366,73 @@ func (o *OrderDep) updatePSQL(ctx *context.Context, order *psqlmodel.Order) erro
 	return nil
 }
 
+func (o *OrderDep) insertStatusHistoryPSQL(ctx *context.Context, tx *sql.Tx, order *psqlmodel.Order, from null.String, actor int) error {
+	history := &psqlmodel.OrderStatusHistory{
+		OrderID:    order.ID,
+		FromStatus: from,
+		ToStatus:   order.Status,
+		CreatedBy:  actor,
+	}
+
+	err := history.Insert(*ctx, tx, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert status history")
+	}
+	return nil
+}
+
+func (o *OrderDep) getStatusHistoryPSQL(ctx *context.Context, orderID int64) (psqlmodel.OrderStatusHistorySlice, error) {
+	history, err := psqlmodel.OrderStatusHistories(
+		qm.Where("order_id=?", orderID),
+		qm.OrderBy("created_at, id"),
+	).All(*ctx, o.DB)
+	if err != nil {
+		return history, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get status history")
+	}
+
+	return history, nil
+}
+
+// checkAvailabilityPSQL locks the ordered car and makes sure no other order
+// rents it within the requested period. It must run inside a transaction.
+func (o *OrderDep) checkAvailabilityPSQL(ctx *context.Context, tx *sql.Tx, order *psqlmodel.Order) error {
+	_, err := psqlmodel.Cars(qm.Where("id=?", order.CarID), qm.For("UPDATE")).One(*ctx, tx)
+	if err == sql.ErrNoRows {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCarID, err, "car not found")
+	}
+
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error lock car")
+	}
+
+	return o.checkOverlapPSQL(ctx, tx, order)
+}
+
+func (o *OrderDep) checkOverlapPSQL(ctx *context.Context, exec boil.ContextExecutor, order *psqlmodel.Order) error {
+	qr := model.GetOverlapQuery(int64(order.CarID), order.PickupDate, order.DropoffDate)
+	if order.ID != 0 {
+		qr = append(qr, qm.Where("id<>?", order.ID))
+	}
+
+	exist, err := psqlmodel.Orders(qr...).Exists(*ctx, exec)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error check availability")
+	}
+
+	if exist {
+		return errormsg.WrapErr(svcerr.OrderSVCCarNotAvailable, nil, "car is not available")
+	}
+
+	return nil
+}
+
+func isExclusionViolation(err error) bool {
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	return ok && pqErr.Code == "23P01"
+}
+
 func (o *OrderDep) deletePSQL(ctx *context.Context, order *psqlmodel.Order, id int64, isHardDelete bool) error {
+	before := *order
 	tx, err := o.DB.BeginTx(*ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
@@ -92,6 +456,27 @@ func (o *OrderDep) deletePSQL(ctx *context.Context, order *psqlmodel.Order, id i
 			return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
 		}
 	}
+
+	err = o.insertOutboxPSQL(ctx, tx, model.OrderEventDeleted, order)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	after := order
+	if isHardDelete {
+		after = nil
+	}
+	err = o.insertAuditPSQL(ctx, tx, model.AuditActionDelete, int(id), &before, after)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
@@ -108,6 +493,10 @@ func (o *OrderDep) getByParamPSQL(ctx *context.Context, param *model.GetOrdersBy
 		}
 	}
 
+	if param.IsCursor() {
+		return o.getByCursorPSQL(ctx, param)
+	}
+
 	if param.Page == 0 {
 		param.Page = 1
 	}
@@ -127,7 +516,7 @@ func (o *OrderDep) getByParamPSQL(ctx *context.Context, param *model.GetOrdersBy
 		return orders, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get orders")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = (count + param.Limit - 1) / param.Limit
 	}
 	return orders, model.Pagination{
 		CurrentPage:     param.Page,
@@ -137,3 +526,92 @@ func (o *OrderDep) getByParamPSQL(ctx *context.Context, param *model.GetOrdersBy
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getByCursorPSQL reads the cursor page of param without counting the rows,
+// the page is read backwards when it goes back and put in order here.
+func (o *OrderDep) getByCursorPSQL(ctx *context.Context, param *model.GetOrdersByParam) (psqlmodel.OrderSlice, model.Pagination, error) {
+	page, err := param.GetCursorPage()
+	if err != nil {
+		return psqlmodel.OrderSlice{}, model.Pagination{}, err
+	}
+
+	qr := append(param.GetQuery(), page.GetQuery()...)
+	orders, err := psqlmodel.Orders(qr...).All(*ctx, o.DB)
+	if err != nil {
+		return orders, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get orders")
+	}
+
+	hasMore := int64(len(orders)) > page.Limit
+	if hasMore {
+		orders = orders[:page.Limit]
+	}
+
+	if page.IsPrev() {
+		for i, j := 0, len(orders)-1; i < j; i, j = i+1, j-1 {
+			orders[i], orders[j] = orders[j], orders[i]
+		}
+	}
+
+	var first, last interface{}
+	if len(orders) > 0 {
+		first, last = orders[0], orders[len(orders)-1]
+	}
+
+	pagination, err := page.GetPagination(first, last, len(orders), hasMore)
+	return orders, pagination, err
+}
+
+// loadCarPSQL returns a copy of orders with their car eager loaded by a
+// single query, orders may be shared with the cache or a single flight so
+// they are left as they are.
+func (o *OrderDep) loadCarPSQL(ctx *context.Context, orders psqlmodel.OrderSlice) (psqlmodel.OrderSlice, error) {
+	res := make(psqlmodel.OrderSlice, 0, len(orders))
+	for _, order := range orders {
+		v := *order
+		v.R = nil
+		res = append(res, &v)
+	}
+
+	var order psqlmodel.Order
+	err := order.L.LoadCar(*ctx, o.DB, false, (*[]*psqlmodel.Order)(&res), nil)
+	if err != nil {
+		return orders, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error load cars")
+	}
+
+	return res, nil
+}
+
+// insertOutboxPSQL records an event on the order within tx, it is published
+// by the outbox relay once tx commits.
+func (o *OrderDep) insertOutboxPSQL(ctx *context.Context, tx *sql.Tx, eventType string, order *psqlmodel.Order) error {
+	outbox, err := model.NewOutbox(model.OutboxAggregateOrder, order.ID, eventType, order)
+	if err != nil {
+		return err
+	}
+
+	err = outbox.Insert(*ctx, tx, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert outbox")
+	}
+	return nil
+}
+
+// insertAuditPSQL records the change of an order made by actor within tx,
+// before is nil for an insert and after is nil for a hard delete.
+func (o *OrderDep) insertAuditPSQL(ctx *context.Context, tx *sql.Tx, action string, actor int, before, after *psqlmodel.Order) error {
+	order := after
+	if order == nil {
+		order = before
+	}
+
+	audit, err := model.NewAuditLog(*ctx, model.AuditEntityOrder, order.ID, action, actor, before, after)
+	if err != nil {
+		return err
+	}
+
+	err = audit.Insert(*ctx, tx, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert audit log")
+	}
+	return nil
+}
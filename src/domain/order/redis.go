@@ -6,6 +6,8 @@ import (
 
 	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+
+	goredislib "github.com/redis/go-redis/v9"
 )
 
 func (o *OrderDep) getSingleByParamRedis(ctx *context.Context, key string) (psqlmodel.Order, error) {
@@ -34,6 +36,32 @@ func (o *OrderDep) setRedis(ctx *context.Context, key string, data string) error
 	return err
 }
 
+// setByParamRedis stores a list entry, with stale while revalidate the entry is
+// kept past its expiration for the stale window.
+func (o *OrderDep) setByParamRedis(ctx *context.Context, key string, data string) error {
+	expTime := o.Conf.RedisExpirationTime
+	if o.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultRedisExpiration
+	}
+	_, err := o.Redis.Del(*ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	_, err = o.Redis.Set(*ctx, key, data, expTime+o.Conf.StaleWhileRevalidate).Result()
+	return err
+}
+
+// isStaleRedis reports whether the entry under key has outlived its expiration
+// and is only kept for the stale window. A key without an expiration (-1) or
+// one already gone (-2) is never stale.
+func (o *OrderDep) isStaleRedis(ctx *context.Context, key string) (bool, error) {
+	ttl, err := o.Redis.PTTL(*ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return ttl >= 0 && ttl < o.Conf.StaleWhileRevalidate, nil
+}
+
 func (o *OrderDep) getByParamRedis(ctx *context.Context, key string) (psqlmodel.OrderSlice, error) {
 	var res psqlmodel.OrderSlice
 	data, err := o.Redis.Get(*ctx, key).Result()
@@ -59,3 +87,48 @@ func (o *OrderDep) getByParamPaginationRedis(ctx *context.Context, key string) (
 	}
 	return res, nil
 }
+
+func (o *OrderDep) reserveIdempotencyRedis(ctx *context.Context, key string, record model.IdempotencyRecord) (bool, error) {
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return false, err
+	}
+	return o.Redis.SetNX(*ctx, key, string(data), model.IdempotencyExpiration).Result()
+}
+
+func (o *OrderDep) getIdempotencyRedis(ctx *context.Context, key string) (model.IdempotencyRecord, error) {
+	var res model.IdempotencyRecord
+	data, err := o.Redis.Get(*ctx, key).Result()
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal([]byte(data), &res)
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (o *OrderDep) setIdempotencyRedis(ctx *context.Context, key string, record model.IdempotencyRecord) error {
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	_, err = o.Redis.Set(*ctx, key, string(data), model.IdempotencyExpiration).Result()
+	return err
+}
+
+// getCacheNamespaceRedis returns the current version of the order cache, a
+// missing namespace key is version 0.
+func (o *OrderDep) getCacheNamespaceRedis(ctx *context.Context) (int64, error) {
+	ns, err := o.Redis.Get(*ctx, model.OrderCacheNamespaceKey).Int64()
+	if err == goredislib.Nil {
+		return 0, nil
+	}
+	return ns, err
+}
+
+func (o *OrderDep) bumpCacheNamespaceRedis(ctx *context.Context) error {
+	_, err := o.Redis.Incr(*ctx, model.OrderCacheNamespaceKey).Result()
+	return err
+}
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type OutboxDep struct {
+	Log       logger.Logger
+	DB        *sql.DB
+	Redis     *goredislib.Client
+	Conf      Conf
+	Publisher Publisher
+}
+
+type Conf struct {
+	BatchSize    int           `mapstructure:"batch_size"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	// ClaimTimeout is how long the events a relay is publishing are kept from
+	// the other relays, they are published again once it runs out.
+	ClaimTimeout time.Duration `mapstructure:"claim_timeout"`
+	Stream       string        `mapstructure:"stream"`
+	StreamMaxLen int64         `mapstructure:"stream_max_len"`
+	Channel      string        `mapstructure:"channel"`
+	// ReplayLimit caps how many stored events a resumed subscription replays.
+	ReplayLimit int `mapstructure:"replay_limit"`
+}
+
+type OutboxInterface interface {
+	Relay(ctx *context.Context, now time.Time) (int, error)
+	Subscribe(ctx context.Context, afterID int64, fn func(event model.OutboxEvent) error) error
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client, publisher Publisher) OutboxInterface {
+	if conf.Channel == "" {
+		conf.Channel = defaultChannel
+	}
+
+	return &OutboxDep{
+		Log:       *log,
+		DB:        db,
+		Redis:     rds,
+		Conf:      conf,
+		Publisher: publisher,
+	}
+}
+
+// Relay publishes one batch of pending events that are due at time now and
+// returns how many were published. An event is marked published only after
+// the publisher accepted it, so it is delivered at least once and may be
+// delivered again if the relay stops in between or takes longer than
+// ClaimTimeout, publishers have to tolerate duplicates. Events of one
+// aggregate are not guaranteed to arrive in order once a delivery is retried.
+func (o *OutboxDep) Relay(ctx *context.Context, now time.Time) (int, error) {
+	return o.relayPSQL(ctx, now)
+}
+
+// Subscribe calls fn with every event broadcast on the pub/sub channel from
+// the moment the subscription is made until ctx is done or fn returns an
+// error. Events reach the channel when the relay publishes them, so they lag
+// behind the write by up to the relay interval. When afterID is above 0 the
+// events stored after it are replayed first, so a client resuming its
+// subscription does not lose the events in between as long as there are no
+// more than ReplayLimit of them.
+func (o *OutboxDep) Subscribe(ctx context.Context, afterID int64, fn func(event model.OutboxEvent) error) error {
+	return o.subscribeRedis(ctx, afterID, fn)
+}
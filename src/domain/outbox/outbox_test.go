@@ -0,0 +1,148 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/outbox"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	gosqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type fakePublisher struct {
+	failIDs   map[int64]bool
+	published []model.OutboxEvent
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	if p.failIDs[event.ID] {
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, event)
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	columns := []string{"id", "aggregate_type", "aggregate_id", "event_type", "payload", "status", "attempts", "last_error", "available_at", "created_at", "published_at"}
+	selectQuery := regexp.QuoteMeta("SELECT \"outbox\".* FROM \"outbox\" WHERE (status=$1) AND (available_at<=$2) ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED;")
+	claimQuery := regexp.QuoteMeta("UPDATE \"outbox\" SET \"available_at\" = $1 WHERE (\"id\" IN ")
+	updateQuery := regexp.QuoteMeta("UPDATE \"outbox\" SET")
+	anyArg := gosqlmock.AnyArg()
+
+	Convey("test relay", t, FailureHalts, func() {
+		tests := []struct {
+			testType      string
+			testDesc      string
+			failIDs       map[int64]bool
+			mockFunc      func()
+			wantPublished int
+		}{
+			{
+				testType: "P",
+				testDesc: "test publish pending events and retry failed one",
+				failIDs:  map[int64]bool{2: true},
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(1, model.OutboxAggregateOrder, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.OutboxStatusPending, 0, nil, now, now, nil).
+						AddRow(2, model.OutboxAggregateCar, 3, model.CarEventUpdated, []byte(`{"id":3}`), model.OutboxStatusPending, 0, nil, now, now, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OutboxStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Minute), 1, 2).WillReturnResult(gosqlmock.NewResult(0, 2))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WithArgs(anyArg, anyArg, anyArg, anyArg, model.OutboxStatusPublished, 0, anyArg, now, now, 1).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectExec(updateQuery).WithArgs(anyArg, anyArg, anyArg, anyArg, model.OutboxStatusPending, 1, "broker unavailable", now.Add(time.Second), nil, 2).WillReturnResult(gosqlmock.NewResult(0, 1))
+				},
+				wantPublished: 1,
+			},
+			{
+				testType: "P",
+				testDesc: "test mark event failed after max attempts",
+				failIDs:  map[int64]bool{3: true},
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(3, model.OutboxAggregateOrder, 10, model.OrderEventDeleted, []byte(`{"id":10}`), model.OutboxStatusPending, 2, "broker unavailable", now, now, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OutboxStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Minute), 3).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WithArgs(anyArg, anyArg, anyArg, anyArg, model.OutboxStatusFailed, 3, "broker unavailable", now.Add(4*time.Second), nil, 3).WillReturnResult(gosqlmock.NewResult(0, 1))
+				},
+				wantPublished: 0,
+			},
+			{
+				testType: "N",
+				testDesc: "test rollback when events can not be claimed",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(4, model.OutboxAggregateOrder, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.OutboxStatusPending, 0, nil, now, now, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OutboxStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectExec(claimQuery).WillReturnError(errors.New("connection reset"))
+					sqlMock.ExpectRollback()
+				},
+				wantPublished: 0,
+			},
+			{
+				testType: "N",
+				testDesc: "test error when published event can not be updated",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(5, model.OutboxAggregateOrder, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.OutboxStatusPending, 0, nil, now, now, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OutboxStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Minute), 5).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WillReturnError(errors.New("connection reset"))
+				},
+				wantPublished: 0,
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				publisher := &fakePublisher{failIDs: test.failIDs}
+				acc := outbox.OutboxDep{
+					Log: logger.New(&logger.Config{}),
+					DB:  dbSQL,
+					Conf: outbox.Conf{
+						BatchSize:    10,
+						MaxAttempts:  3,
+						RetryBackoff: time.Second,
+						ClaimTimeout: time.Minute,
+					},
+					Publisher: publisher,
+				}
+				test.mockFunc()
+				published, err := acc.Relay(&ctx, now)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(published, ShouldEqual, test.wantPublished)
+					So(len(publisher.published), ShouldEqual, test.wantPublished)
+				}
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (o *OutboxDep) relayPSQL(ctx *context.Context, now time.Time) (int, error) {
+	maxAttempts := o.Conf.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = model.DefaultOutboxMaxAttempts
+	}
+
+	backoff := o.Conf.RetryBackoff
+	if backoff == 0 {
+		backoff = model.DefaultOutboxRetryBackoff
+	}
+
+	events, err := o.claimPSQL(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// the events are published outside of the claim transaction, so a slow
+	// broker holds neither a connection nor a lock.
+	published := 0
+	for _, event := range events {
+		err = o.Publisher.Publish(*ctx, model.TransformOutboxToOutboxEvent(event))
+		if err != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error publish outbox event"))
+			event.Attempts++
+			event.LastError = null.StringFrom(err.Error())
+			event.AvailableAt = model.GetOutboxRetryAt(now, event.Attempts, backoff)
+			if event.Attempts >= maxAttempts {
+				event.Status = model.OutboxStatusFailed
+			}
+		} else {
+			event.Status = model.OutboxStatusPublished
+			event.PublishedAt = null.TimeFrom(now)
+			published++
+		}
+
+		_, err = event.Update(*ctx, o.DB, boil.Infer())
+		if err != nil {
+			return published, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update outbox")
+		}
+	}
+
+	return published, nil
+}
+
+// claimPSQL returns a batch of pending events that are due at time now, they
+// are made available again only after ClaimTimeout so no other relay picks
+// them up meanwhile. An event left behind by a relay that stopped is
+// published again once its claim runs out.
+func (o *OutboxDep) claimPSQL(ctx *context.Context, now time.Time) (psqlmodel.OutboxSlice, error) {
+	batchSize := o.Conf.BatchSize
+	if batchSize == 0 {
+		batchSize = model.DefaultOutboxBatchSize
+	}
+
+	claimTimeout := o.Conf.ClaimTimeout
+	if claimTimeout == 0 {
+		claimTimeout = model.DefaultOutboxClaimTimeout
+	}
+
+	tx, err := o.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// rows locked by another relay are skipped, so relays can run side by side
+	// without claiming the same event twice.
+	events, err := psqlmodel.Outboxes(
+		qm.Where("status=?", model.OutboxStatusPending),
+		qm.Where("available_at<=?", now),
+		qm.OrderBy("id"),
+		qm.Limit(batchSize),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get outbox")
+	}
+
+	if len(events) > 0 {
+		ids := make([]interface{}, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.ID)
+		}
+
+		_, err = psqlmodel.Outboxes(qm.WhereIn("id IN ?", ids...)).UpdateAll(*ctx, tx, psqlmodel.M{
+			psqlmodel.OutboxColumns.AvailableAt: now.Add(claimTimeout),
+		})
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				o.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error claim outbox")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return events, nil
+}
+
+// getAfterPSQL returns up to limit events stored after the event with id
+// afterID whatever their status, oldest first.
+func (o *OutboxDep) getAfterPSQL(ctx context.Context, afterID int64, limit int) (psqlmodel.OutboxSlice, error) {
+	events, err := psqlmodel.Outboxes(
+		qm.Where("id>?", afterID),
+		qm.OrderBy("id"),
+		qm.Limit(limit),
+	).All(ctx, o.DB)
+	if err != nil {
+		return events, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get outbox")
+	}
+
+	return events, nil
+}
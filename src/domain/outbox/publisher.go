@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultStream is used when stream is not configured.
+	defaultStream = "carrent:ordersvc:events"
+	// defaultChannel is used when channel is not configured.
+	defaultChannel = "carrent:ordersvc:events:live"
+)
+
+// Publisher delivers outbox events to other services. Publish must return nil
+// only once the event is stored by the broker, the event is retried otherwise.
+type Publisher interface {
+	Publish(ctx context.Context, event model.OutboxEvent) error
+}
+
+// RedisStreamPublisher appends events to a redis stream, consumers read it
+// with XREAD or a consumer group.
+type RedisStreamPublisher struct {
+	Redis  *goredislib.Client
+	Stream string
+	MaxLen int64
+}
+
+func NewRedisStreamPublisher(rds *goredislib.Client, stream string, maxLen int64) Publisher {
+	if stream == "" {
+		stream = defaultStream
+	}
+
+	return &RedisStreamPublisher{
+		Redis:  rds,
+		Stream: stream,
+		MaxLen: maxLen,
+	}
+}
+
+func (p *RedisStreamPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	_, err := p.Redis.XAdd(ctx, &goredislib.XAddArgs{
+		Stream: p.Stream,
+		MaxLen: p.MaxLen,
+		Approx: p.MaxLen > 0,
+		Values: map[string]interface{}{
+			"id":             event.ID,
+			"aggregate_type": event.AggregateType,
+			"aggregate_id":   event.AggregateID,
+			"event_type":     event.EventType,
+			"payload":        string(event.Payload),
+			"created_at":     event.CreatedAt.Format(time.RFC3339),
+		},
+	}).Result()
+	return err
+}
+
+// RedisPubSubPublisher broadcasts events to a redis pub/sub channel, every
+// ordersvc replica subscribed to it receives the event. Subscribers which are
+// not connected at that moment miss the event.
+type RedisPubSubPublisher struct {
+	Redis   *goredislib.Client
+	Channel string
+}
+
+func NewRedisPubSubPublisher(rds *goredislib.Client, channel string) Publisher {
+	if channel == "" {
+		channel = defaultChannel
+	}
+
+	return &RedisPubSubPublisher{
+		Redis:   rds,
+		Channel: channel,
+	}
+}
+
+func (p *RedisPubSubPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return p.Redis.Publish(ctx, p.Channel, data).Err()
+}
+
+// MultiPublisher hands every event to each of its publishers. The event is
+// retried when any of them fails, so the others may receive it again.
+type MultiPublisher struct {
+	Publishers []Publisher
+}
+
+func NewMultiPublisher(publishers ...Publisher) Publisher {
+	return &MultiPublisher{
+		Publishers: publishers,
+	}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	var errs []error
+	for _, publisher := range p.Publishers {
+		err := publisher.Publish(ctx, event)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,59 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/outbox"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	"github.com/go-redis/redismock/v9"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRedisPubSubPublisher(t *testing.T) {
+	ctx := context.Background()
+	event := model.OutboxEvent{
+		ID:            10,
+		AggregateType: model.OutboxAggregateOrder,
+		AggregateID:   3,
+		EventType:     model.OrderEventCreated,
+		Payload:       json.RawMessage(`{"id":3}`),
+		CreatedAt:     time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC),
+	}
+	data, _ := json.Marshal(event)
+
+	Convey("test publish event to pub/sub channel", t, FailureHalts, func() {
+		dbRedis, redisMock := redismock.NewClientMock()
+		publisher := outbox.NewRedisPubSubPublisher(dbRedis, "")
+
+		Convey("0 - [P] : test publish to default channel", func() {
+			redisMock.ExpectPublish("carrent:ordersvc:events:live", data).SetVal(1)
+			So(publisher.Publish(ctx, event), ShouldBeNil)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("1 - [N] : test redis error", func() {
+			redisMock.ExpectPublish("carrent:ordersvc:events:live", data).SetErr(errors.New("connection refused"))
+			So(publisher.Publish(ctx, event), ShouldNotBeNil)
+			So(redisMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestMultiPublisher(t *testing.T) {
+	ctx := context.Background()
+	Convey("test publish event to every publisher", t, FailureHalts, func() {
+		ok := &fakePublisher{}
+		failing := &fakePublisher{failIDs: map[int64]bool{1: true}}
+		publisher := outbox.NewMultiPublisher(failing, ok)
+
+		So(publisher.Publish(ctx, model.OutboxEvent{ID: 1}), ShouldNotBeNil)
+		So(publisher.Publish(ctx, model.OutboxEvent{ID: 2}), ShouldBeNil)
+		So(len(ok.published), ShouldEqual, 2)
+		So(len(failing.published), ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+func (o *OutboxDep) subscribeRedis(ctx context.Context, afterID int64, fn func(event model.OutboxEvent) error) error {
+	pubsub := o.Redis.Subscribe(ctx, o.Conf.Channel)
+	defer pubsub.Close()
+
+	// wait for the subscription to be confirmed, events published before that
+	// are not received.
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error subscribe events")
+	}
+
+	// the relay may broadcast a replayed event again, it is only sent once.
+	replayed, err := o.replay(ctx, afterID, fn)
+	if err != nil {
+		return err
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return errormsg.WrapErr(svcerr.OrderSVCBadRequest, nil, "event subscription closed")
+			}
+
+			var event model.OutboxEvent
+			err = json.Unmarshal([]byte(msg.Payload), &event)
+			if err != nil {
+				o.Log.Warn(ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal event"))
+				continue
+			}
+
+			if replayed[event.ID] {
+				continue
+			}
+
+			err = fn(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replay calls fn with the events stored after afterID and returns their ids.
+func (o *OutboxDep) replay(ctx context.Context, afterID int64, fn func(event model.OutboxEvent) error) (map[int64]bool, error) {
+	replayed := make(map[int64]bool)
+	if afterID <= 0 {
+		return replayed, nil
+	}
+
+	limit := o.Conf.ReplayLimit
+	if limit == 0 {
+		limit = model.DefaultOutboxReplayLimit
+	}
+
+	events, err := o.getAfterPSQL(ctx, afterID, limit)
+	if err != nil {
+		return replayed, err
+	}
+
+	for _, event := range events {
+		err = fn(model.TransformOutboxToOutboxEvent(event))
+		if err != nil {
+			return replayed, err
+		}
+		replayed[int64(event.ID)] = true
+	}
+
+	return replayed, nil
+}
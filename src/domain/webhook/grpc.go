@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+func (w *WebhookDep) InsertGRPC(ctx context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error) {
+	client, err := w.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.CreateWebhook(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (w *WebhookDep) GetByOwnerGRPC(ctx context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error) {
+	client, err := w.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.GetWebhooks(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (w *WebhookDep) DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error) {
+	client, err := w.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.DeleteWebhook(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (w *WebhookDep) GetDeliveriesGRPC(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error) {
+	client, err := w.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.GetWebhookDeliveries(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
+
+func (w *WebhookDep) ReplayGRPC(ctx context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error) {
+	client, err := w.Grpc.Get()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCErrorGRPCClient, err, "error grpc client connection")
+	}
+
+	clientService := grpcmodel.NewOrderClient(client.Conn)
+
+	ctx, cancel := context.WithTimeout(model.NewOutgoingContext(ctx), time.Second)
+	defer cancel()
+	res, err := clientService.ReplayWebhookDelivery(ctx, v)
+	if err != nil {
+		return nil, svcerr.FromGRPCStatus(err, "error grpc client")
+	}
+
+	client.Release()
+	return res, nil
+}
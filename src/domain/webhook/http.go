@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+)
+
+// maxResponseBody is how much of a webhook response is read before the
+// connection is reused, the body itself is not kept.
+const maxResponseBody = 64 << 10
+
+// newClient returns the client deliveries are sent with, it only connects to
+// public addresses. The address is checked once the host is resolved, so a
+// host pointed at the internal network after its webhook was created is
+// refused as well as a redirect to it.
+func newClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would connect to the webhook on our behalf past the check.
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublic,
+	}).DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+// dialPublic refuses to connect to an address that is not public.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !model.IsPublicIP(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+// post sends the delivery to its webhook and returns the response status, or
+// 0 when no response was received. Only a 2xx response counts as delivered.
+func (w *WebhookDep) post(ctx context.Context, webhook *psqlmodel.Webhook, delivery *psqlmodel.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(model.NewWebhookPayload(delivery))
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(model.WebhookEventHeader, delivery.EventType)
+	req.Header.Set(model.WebhookDeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(model.WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(model.WebhookSignatureHeader, model.GetWebhookSignature(webhook.Secret, timestamp, body))
+
+	res, err := w.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBody))
+	if err != nil {
+		w.Log.Warn(ctx, err)
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return res.StatusCode, fmt.Errorf("unexpected response status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,327 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func (w *WebhookDep) insertPSQL(ctx *context.Context, data *psqlmodel.Webhook) error {
+	err := data.Insert(*ctx, w.DB, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert webhook")
+	}
+	return nil
+}
+
+func (w *WebhookDep) getByOwnerPSQL(ctx *context.Context, ownerID int64) (psqlmodel.WebhookSlice, error) {
+	webhooks, err := psqlmodel.Webhooks(
+		qm.Where("created_by=?", ownerID),
+		qm.OrderBy("id"),
+	).All(*ctx, w.DB)
+	if err != nil {
+		return webhooks, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhooks")
+	}
+
+	return webhooks, nil
+}
+
+func (w *WebhookDep) getSingleByOwnerPSQL(ctx *context.Context, id int64, ownerID int64) (psqlmodel.Webhook, error) {
+	webhook, err := psqlmodel.Webhooks(
+		qm.Where("id=?", id),
+		qm.Where("created_by=?", ownerID),
+	).One(*ctx, w.DB)
+	if err == sql.ErrNoRows {
+		return psqlmodel.Webhook{}, errormsg.WrapErr(svcerr.OrderSVCNotFound, err, "webhook not found")
+	}
+
+	if err != nil {
+		return psqlmodel.Webhook{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhook")
+	}
+
+	return *webhook, nil
+}
+
+func (w *WebhookDep) deletePSQL(ctx *context.Context, webhook *psqlmodel.Webhook, id int64) error {
+	tx, err := w.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = webhook.Delete(*ctx, tx, false)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error delete")
+	}
+
+	webhook.DeletedBy = null.NewInt(int(id), true)
+	_, err = webhook.Update(*ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (w *WebhookDep) getDeliveriesPSQL(ctx *context.Context, param *model.GetWebhookDeliveriesByParam) (psqlmodel.WebhookDeliverySlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(w.Conf.DefaultPageLimit)
+		if w.Conf.DefaultPageLimit == 0 {
+			param.Limit = int64(model.DefaultPageLimit)
+		}
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := psqlmodel.WebhookDeliveries(qr...).Count(*ctx, w.DB)
+	if err != nil {
+		return psqlmodel.WebhookDeliverySlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error count data")
+	}
+	qr = append(qr, qm.OrderBy("id DESC"))
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	deliveries, err := psqlmodel.WebhookDeliveries(qr...).All(*ctx, w.DB)
+	if err != nil {
+		return deliveries, model.Pagination{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhook deliveries")
+	}
+	if count > 0 {
+		totalPages = (count + param.Limit - 1) / param.Limit
+	}
+	return deliveries, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(deliveries)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          "id DESC",
+	}, nil
+}
+
+func (w *WebhookDep) getSingleDeliveryPSQL(ctx *context.Context, webhookID int64, id int64) (psqlmodel.WebhookDelivery, error) {
+	delivery, err := psqlmodel.WebhookDeliveries(
+		qm.Where("id=?", id),
+		qm.Where("webhook_id=?", webhookID),
+	).One(*ctx, w.DB)
+	if err == sql.ErrNoRows {
+		return psqlmodel.WebhookDelivery{}, errormsg.WrapErr(svcerr.OrderSVCNotFound, err, "webhook delivery not found")
+	}
+
+	if err != nil {
+		return psqlmodel.WebhookDelivery{}, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhook delivery")
+	}
+
+	return *delivery, nil
+}
+
+// replayPSQL resets a delivered or failed delivery to pending. A pending
+// delivery may be claimed and sent by a worker right now, replaying it would
+// send it twice, so it is refused.
+func (w *WebhookDep) replayPSQL(ctx *context.Context, delivery *psqlmodel.WebhookDelivery, now time.Time) error {
+	rows, err := psqlmodel.WebhookDeliveries(
+		qm.Where("id=?", delivery.ID),
+		qm.WhereIn("status IN ?", model.WebhookDeliveryStatusDelivered, model.WebhookDeliveryStatusFailed),
+	).UpdateAll(*ctx, w.DB, psqlmodel.M{
+		psqlmodel.WebhookDeliveryColumns.Status:         model.WebhookDeliveryStatusPending,
+		psqlmodel.WebhookDeliveryColumns.Attempts:       0,
+		psqlmodel.WebhookDeliveryColumns.NextAttemptAt:  now,
+		psqlmodel.WebhookDeliveryColumns.LastError:      null.String{},
+		psqlmodel.WebhookDeliveryColumns.ResponseStatus: null.Int{},
+		psqlmodel.WebhookDeliveryColumns.DeliveredAt:    null.Time{},
+		psqlmodel.WebhookDeliveryColumns.UpdatedAt:      now,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update webhook delivery")
+	}
+
+	if rows == 0 {
+		return errormsg.WrapErr(svcerr.OrderSVCDeliveryNotReplayable, nil, "webhook delivery is "+delivery.Status)
+	}
+
+	delivery.Status = model.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.LastError = null.String{}
+	delivery.ResponseStatus = null.Int{}
+	delivery.DeliveredAt = null.Time{}
+	delivery.UpdatedAt = now
+	return nil
+}
+
+// insertDeliveriesPSQL records a delivery of event for every webhook subscribed
+// to its type whose owner created the order or has a role that may read any
+// order. A webhook that already has a delivery of the event is skipped, so
+// the outbox relay may publish an event more than once.
+func (w *WebhookDep) insertDeliveriesPSQL(ctx *context.Context, event model.OutboxEvent) error {
+	owner, err := model.GetOrderEventOwner(event)
+	if err != nil {
+		return err
+	}
+
+	tx, err := w.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	webhooks, err := psqlmodel.Webhooks(
+		qm.Where("?=ANY(event_types)", event.EventType),
+		qm.Where("(created_by=? OR created_scope=ANY(?))", owner, types.StringArray(w.Permission.GetRoles(model.PermissionOrderReadAny))),
+	).All(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhooks")
+	}
+
+	for _, webhook := range webhooks {
+		delivery := model.NewWebhookDelivery(webhook.ID, event)
+		err = delivery.Upsert(*ctx, tx, false, []string{psqlmodel.WebhookDeliveryColumns.WebhookID, psqlmodel.WebhookDeliveryColumns.OutboxID}, boil.None(), boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.OrderSVCPSQLErrorInsert, err, "error insert webhook delivery")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (w *WebhookDep) deliverPSQL(ctx *context.Context, now time.Time) (int, error) {
+	maxAttempts := w.Conf.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = model.DefaultWebhookMaxAttempts
+	}
+
+	backoff := w.Conf.RetryBackoff
+	if backoff == 0 {
+		backoff = model.DefaultWebhookRetryBackoff
+	}
+
+	deliveries, err := w.claimDeliveriesPSQL(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// the deliveries are sent outside of the claim transaction, so a slow
+	// webhook holds neither a connection nor a lock.
+	delivered := 0
+	for _, delivery := range deliveries {
+		webhook := delivery.R.GetWebhook()
+		if webhook == nil {
+			// the webhook is deleted, there is nowhere to send the delivery.
+			delivery.Status = model.WebhookDeliveryStatusFailed
+			delivery.LastError = null.StringFrom("webhook is deleted")
+		} else {
+			delivery.Attempts++
+			status, err := w.post(*ctx, webhook, delivery)
+			delivery.ResponseStatus = null.NewInt(status, status != 0)
+			if err != nil {
+				w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error send webhook delivery"))
+				delivery.LastError = null.StringFrom(err.Error())
+				delivery.NextAttemptAt = model.GetWebhookRetryAt(now, delivery.Attempts, backoff)
+				if delivery.Attempts >= maxAttempts {
+					delivery.Status = model.WebhookDeliveryStatusFailed
+				}
+			} else {
+				delivery.Status = model.WebhookDeliveryStatusDelivered
+				delivery.LastError = null.String{}
+				delivery.DeliveredAt = null.TimeFrom(now)
+				delivered++
+			}
+		}
+
+		_, err = delivery.Update(*ctx, w.DB, boil.Infer())
+		if err != nil {
+			return delivered, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error update webhook delivery")
+		}
+	}
+
+	return delivered, nil
+}
+
+// claimDeliveriesPSQL returns a batch of pending deliveries that are due at
+// time now, their next attempt is moved past the time it takes to send the
+// batch so no other worker picks them up meanwhile. A delivery left behind by
+// a worker that stopped is sent again once its claim runs out.
+func (w *WebhookDep) claimDeliveriesPSQL(ctx *context.Context, now time.Time) (psqlmodel.WebhookDeliverySlice, error) {
+	batchSize := w.Conf.BatchSize
+	if batchSize == 0 {
+		batchSize = model.DefaultWebhookBatchSize
+	}
+
+	timeout := w.Conf.Timeout
+	if timeout == 0 {
+		timeout = model.DefaultWebhookTimeout
+	}
+
+	tx, err := w.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// rows locked by another worker are skipped, so workers can run side by
+	// side without sending the same delivery twice.
+	deliveries, err := psqlmodel.WebhookDeliveries(
+		qm.Load(psqlmodel.WebhookDeliveryRels.Webhook),
+		qm.Where("status=?", model.WebhookDeliveryStatusPending),
+		qm.Where("next_attempt_at<=?", now),
+		qm.OrderBy("id"),
+		qm.Limit(batchSize),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(*ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorGet, err, "error get webhook deliveries")
+	}
+
+	if len(deliveries) > 0 {
+		ids := make([]interface{}, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+
+		_, err = psqlmodel.WebhookDeliveries(qm.WhereIn("id IN ?", ids...)).UpdateAll(*ctx, tx, psqlmodel.M{
+			psqlmodel.WebhookDeliveryColumns.NextAttemptAt: now.Add(time.Duration(len(deliveries)) * timeout),
+		})
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				w.Log.Warn(*ctx, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return nil, errormsg.WrapErr(svcerr.OrderSVCPSQLErrorUpdate, err, "error claim webhook deliveries")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error commit")
+	}
+	return deliveries, nil
+}
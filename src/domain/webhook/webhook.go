@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/grpcclientpool"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
+)
+
+type WebhookDep struct {
+	Log    logger.Logger
+	DB     *sql.DB
+	Conf   Conf
+	Grpc   *grpcclientpool.CPool
+	Client *http.Client
+	// Permission tells the owners who may receive the orders of others.
+	Permission *policy.Permission
+}
+
+type Conf struct {
+	DefaultPageLimit int           `mapstructure:"page_limit"`
+	BatchSize        int           `mapstructure:"batch_size"`
+	MaxAttempts      int           `mapstructure:"max_attempts"`
+	RetryBackoff     time.Duration `mapstructure:"retry_backoff"`
+	// Timeout bounds a single delivery request, a slower webhook is retried.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type WebhookInterface interface {
+	Insert(ctx *context.Context, v *psqlmodel.Webhook) error
+	GetByOwner(ctx *context.Context, ownerID int64) (psqlmodel.WebhookSlice, error)
+	GetSingleByOwner(ctx *context.Context, id int64, ownerID int64) (psqlmodel.Webhook, error)
+	Delete(ctx *context.Context, v *psqlmodel.Webhook, id int64) error
+	GetDeliveries(ctx *context.Context, param *model.GetWebhookDeliveriesByParam) (psqlmodel.WebhookDeliverySlice, model.Pagination, error)
+	GetSingleDelivery(ctx *context.Context, webhookID int64, id int64) (psqlmodel.WebhookDelivery, error)
+	Replay(ctx *context.Context, v *psqlmodel.WebhookDelivery, now time.Time) error
+	Deliver(ctx *context.Context, now time.Time) (int, error)
+	Publish(ctx context.Context, event model.OutboxEvent) error
+
+	// grpc client
+	InsertGRPC(ctx context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error)
+	GetByOwnerGRPC(ctx context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error)
+	DeleteGRPC(ctx context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error)
+	GetDeliveriesGRPC(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error)
+	ReplayGRPC(ctx context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error)
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, grpc *grpcclientpool.CPool, permission *policy.Permission) WebhookInterface {
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = model.DefaultWebhookTimeout
+	}
+
+	return &WebhookDep{
+		Log:        *log,
+		DB:         db,
+		Conf:       conf,
+		Grpc:       grpc,
+		Client:     newClient(timeout),
+		Permission: permission,
+	}
+}
+
+func (w *WebhookDep) Insert(ctx *context.Context, v *psqlmodel.Webhook) error {
+	return w.insertPSQL(ctx, v)
+}
+
+// GetByOwner returns the webhooks created by ownerID.
+func (w *WebhookDep) GetByOwner(ctx *context.Context, ownerID int64) (psqlmodel.WebhookSlice, error) {
+	return w.getByOwnerPSQL(ctx, ownerID)
+}
+
+// GetSingleByOwner returns the webhook id provided it was created by ownerID,
+// the webhook of another owner is reported as not found.
+func (w *WebhookDep) GetSingleByOwner(ctx *context.Context, id int64, ownerID int64) (psqlmodel.Webhook, error) {
+	return w.getSingleByOwnerPSQL(ctx, id, ownerID)
+}
+
+// Delete soft deletes the webhook, its pending deliveries fail on their next
+// attempt.
+func (w *WebhookDep) Delete(ctx *context.Context, v *psqlmodel.Webhook, id int64) error {
+	return w.deletePSQL(ctx, v, id)
+}
+
+func (w *WebhookDep) GetDeliveries(ctx *context.Context, param *model.GetWebhookDeliveriesByParam) (psqlmodel.WebhookDeliverySlice, model.Pagination, error) {
+	return w.getDeliveriesPSQL(ctx, param)
+}
+
+func (w *WebhookDep) GetSingleDelivery(ctx *context.Context, webhookID int64, id int64) (psqlmodel.WebhookDelivery, error) {
+	return w.getSingleDeliveryPSQL(ctx, webhookID, id)
+}
+
+// Replay schedules the delivery to be sent again from time now with a fresh
+// set of attempts, whatever its current status is.
+func (w *WebhookDep) Replay(ctx *context.Context, v *psqlmodel.WebhookDelivery, now time.Time) error {
+	return w.replayPSQL(ctx, v, now)
+}
+
+// Deliver sends one batch of pending deliveries that are due at time now and
+// returns how many were accepted by their webhook. A failed delivery is
+// retried with an exponential backoff until it runs out of attempts.
+func (w *WebhookDep) Deliver(ctx *context.Context, now time.Time) (int, error) {
+	return w.deliverPSQL(ctx, now)
+}
+
+// Publish records a pending delivery of an order event for every webhook
+// subscribed to it, so the webhook domain can be used as an outbox publisher.
+// The event only goes to the webhooks of the order creator and of the owners
+// who may read any order. Publishing the same event again does not duplicate
+// its deliveries.
+func (w *WebhookDep) Publish(ctx context.Context, event model.OutboxEvent) error {
+	if event.AggregateType != model.OutboxAggregateOrder {
+		return nil
+	}
+
+	return w.insertDeliveriesPSQL(&ctx, event)
+}
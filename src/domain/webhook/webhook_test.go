@@ -0,0 +1,416 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/webhook"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
+
+	gosqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const secret = "s3cr3t"
+
+type receiver struct {
+	mu         sync.Mutex
+	signatures []bool
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	timestamp, _ := strconv.ParseInt(req.Header.Get(model.WebhookTimestampHeader), 10, 64)
+
+	r.mu.Lock()
+	r.signatures = append(r.signatures, req.Header.Get(model.WebhookSignatureHeader) == model.GetWebhookSignature(secret, timestamp, body))
+	r.mu.Unlock()
+
+	if req.URL.Path == "/fail" {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestDeliver(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+
+	rcv := &receiver{}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	columns := []string{"id", "webhook_id", "outbox_id", "event_type", "payload", "status", "attempts", "response_status", "last_error", "next_attempt_at", "delivered_at", "created_at", "updated_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	selectQuery := regexp.QuoteMeta("SELECT \"webhook_deliveries\".* FROM \"webhook_deliveries\" WHERE (status=$1) AND (next_attempt_at<=$2) ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED;")
+	webhookQuery := regexp.QuoteMeta("SELECT * FROM \"webhooks\" WHERE")
+	claimQuery := regexp.QuoteMeta("UPDATE \"webhook_deliveries\" SET \"next_attempt_at\" = $1 WHERE (\"id\" IN (")
+	updateQuery := regexp.QuoteMeta("UPDATE \"webhook_deliveries\" SET \"webhook_id\"")
+	anyArg := gosqlmock.AnyArg()
+
+	Convey("test deliver", t, FailureHalts, func() {
+		tests := []struct {
+			testType       string
+			testDesc       string
+			mockFunc       func()
+			wantDelivered  int
+			wantSignatures int
+		}{
+			{
+				testType: "P",
+				testDesc: "test send signed deliveries and retry failed one",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(1, 1, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now).
+						AddRow(2, 2, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now)
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(1, server.URL+"/ok", secret, "{order.created}", 7, now, 7, now, nil, nil).
+						AddRow(2, server.URL+"/fail", secret, "{order.created}", 7, now, 7, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(rows)
+					// the eager load does not keep the order of the webhook ids.
+					sqlMock.ExpectQuery(webhookQuery).WithArgs(anyArg, anyArg).WillReturnRows(webhooks)
+					// both deliveries are claimed for as long as sending them may take.
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(2*time.Second), 1, 2).WillReturnResult(gosqlmock.NewResult(0, 2))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WithArgs(1, 10, anyArg, anyArg, model.WebhookDeliveryStatusDelivered, 1, http.StatusOK, nil, now, now, anyArg, 1).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectExec(updateQuery).WithArgs(2, 10, anyArg, anyArg, model.WebhookDeliveryStatusPending, 1, http.StatusInternalServerError, "unexpected response status 500", now.Add(time.Second), nil, anyArg, 2).WillReturnResult(gosqlmock.NewResult(0, 1))
+				},
+				wantDelivered:  1,
+				wantSignatures: 2,
+			},
+			{
+				testType: "P",
+				testDesc: "test mark delivery failed after max attempts",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(3, 2, 11, model.OrderEventUpdated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 2, 500, "unexpected response status 500", now, nil, now, now)
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(2, server.URL+"/fail", secret, "{order.updated}", 7, now, 7, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectQuery(webhookQuery).WithArgs(2).WillReturnRows(webhooks)
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Second), 3).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WithArgs(2, 11, anyArg, anyArg, model.WebhookDeliveryStatusFailed, 3, http.StatusInternalServerError, "unexpected response status 500", now.Add(4*time.Second), nil, anyArg, 3).WillReturnResult(gosqlmock.NewResult(0, 1))
+				},
+				wantDelivered:  0,
+				wantSignatures: 1,
+			},
+			{
+				testType: "P",
+				testDesc: "test fail delivery of deleted webhook without sending it",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(4, 5, 12, model.OrderEventDeleted, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectQuery(webhookQuery).WithArgs(5).WillReturnRows(sqlMock.NewRows(webhookColumns))
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Second), 4).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WithArgs(5, 12, anyArg, anyArg, model.WebhookDeliveryStatusFailed, 0, nil, "webhook is deleted", now, nil, anyArg, 4).WillReturnResult(gosqlmock.NewResult(0, 1))
+				},
+				wantDelivered:  0,
+				wantSignatures: 0,
+			},
+			{
+				testType: "P",
+				testDesc: "test commit without claim when nothing is due",
+				mockFunc: func() {
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(sqlMock.NewRows(columns))
+					sqlMock.ExpectCommit()
+				},
+				wantDelivered:  0,
+				wantSignatures: 0,
+			},
+			{
+				testType: "N",
+				testDesc: "test rollback without sending when deliveries can not be claimed",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(5, 1, 13, model.OrderEventCreated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now)
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(1, server.URL+"/ok", secret, "{order.created}", 7, now, 7, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectQuery(webhookQuery).WithArgs(1).WillReturnRows(webhooks)
+					sqlMock.ExpectExec(claimQuery).WillReturnError(errors.New("connection reset"))
+					sqlMock.ExpectRollback()
+				},
+				wantSignatures: 0,
+			},
+			{
+				testType: "N",
+				testDesc: "test error when sent delivery can not be updated",
+				mockFunc: func() {
+					rows := sqlMock.NewRows(columns).
+						AddRow(6, 1, 14, model.OrderEventCreated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now)
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(1, server.URL+"/ok", secret, "{order.created}", 7, now, 7, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.WebhookDeliveryStatusPending, now).WillReturnRows(rows)
+					sqlMock.ExpectQuery(webhookQuery).WithArgs(1).WillReturnRows(webhooks)
+					sqlMock.ExpectExec(claimQuery).WithArgs(now.Add(time.Second), 6).WillReturnResult(gosqlmock.NewResult(0, 1))
+					sqlMock.ExpectCommit()
+					sqlMock.ExpectExec(updateQuery).WillReturnError(errors.New("connection reset"))
+				},
+				wantSignatures: 1,
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				rcv.signatures = nil
+				acc := webhook.WebhookDep{
+					Log: logger.New(&logger.Config{}),
+					DB:  dbSQL,
+					Conf: webhook.Conf{
+						BatchSize:    10,
+						MaxAttempts:  3,
+						RetryBackoff: time.Second,
+						Timeout:      time.Second,
+					},
+					Client: server.Client(),
+				}
+				test.mockFunc()
+				delivered, err := acc.Deliver(&ctx, now)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(delivered, ShouldEqual, test.wantDelivered)
+				}
+				So(len(rcv.signatures), ShouldEqual, test.wantSignatures)
+				for _, valid := range rcv.signatures {
+					So(valid, ShouldBeTrue)
+				}
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
+
+func TestDeliverPrivateAddress(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer dbSQL.Close()
+
+	rcv := &receiver{}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	columns := []string{"id", "webhook_id", "outbox_id", "event_type", "payload", "status", "attempts", "response_status", "last_error", "next_attempt_at", "delivered_at", "created_at", "updated_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	anyArg := gosqlmock.AnyArg()
+
+	Convey("test deliver to private address", t, func() {
+		Convey("0 - [N] : test refuse to connect and retry delivery", func() {
+			log := logger.New(&logger.Config{})
+			acc := webhook.New(webhook.Conf{BatchSize: 10, MaxAttempts: 3, RetryBackoff: time.Second, Timeout: time.Second}, &log, dbSQL, nil, policy.New(policy.Config{}))
+			rows := sqlMock.NewRows(columns).
+				AddRow(1, 1, 10, model.OrderEventCreated, []byte(`{"id":10}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now)
+			webhooks := sqlMock.NewRows(webhookColumns).
+				AddRow(1, server.URL+"/ok", secret, "{order.created}", 7, now, 7, now, nil, nil)
+			sqlMock.ExpectBegin()
+			sqlMock.ExpectQuery("SELECT").WillReturnRows(rows)
+			sqlMock.ExpectQuery("SELECT").WithArgs(1).WillReturnRows(webhooks)
+			sqlMock.ExpectExec("UPDATE").WithArgs(now.Add(time.Second), 1).WillReturnResult(gosqlmock.NewResult(0, 1))
+			sqlMock.ExpectCommit()
+			sqlMock.ExpectExec("UPDATE").WithArgs(1, 10, anyArg, anyArg, model.WebhookDeliveryStatusPending, 1, nil, anyArg, now.Add(time.Second), nil, anyArg, 1).WillReturnResult(gosqlmock.NewResult(0, 1))
+
+			delivered, err := acc.Deliver(&ctx, now)
+			So(err, ShouldBeNil)
+			So(delivered, ShouldEqual, 0)
+			So(rcv.signatures, ShouldBeEmpty)
+			So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestPublish(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	columns := []string{"id", "webhook_id", "outbox_id", "event_type", "payload", "status", "attempts", "response_status", "last_error", "next_attempt_at", "delivered_at", "created_at", "updated_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "created_by", "created_scope", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	selectQuery := regexp.QuoteMeta("SELECT \"webhooks\".* FROM \"webhooks\" WHERE ($1=ANY(event_types)) AND ((created_by=$2 OR created_scope=ANY($3))) AND (\"webhooks\".\"deleted_at\" is null);")
+	// only the roles that may read any order receive the orders of others.
+	readAnyScopes := `{"sto","sup"}`
+	insertQuery := regexp.QuoteMeta("INSERT INTO \"webhook_deliveries\"")
+
+	Convey("test publish", t, FailureHalts, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			event    model.OutboxEvent
+			mockFunc func()
+		}{
+			{
+				testType: "P",
+				testDesc: "test record delivery for every subscribed webhook",
+				event:    model.OutboxEvent{ID: 10, AggregateType: model.OutboxAggregateOrder, AggregateID: 3, EventType: model.OrderEventCreated, Payload: []byte(`{"id":3,"created_by":7}`)},
+				mockFunc: func() {
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(1, "https://a.example.com", secret, "{order.created}", 7, model.CustomerScope, now, 7, now, nil, nil).
+						AddRow(2, "https://b.example.com", secret, "{order.created,order.deleted}", 8, model.StoreScope, now, 8, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OrderEventCreated, int64(7), readAnyScopes).WillReturnRows(webhooks)
+					sqlMock.ExpectQuery(insertQuery).WillReturnRows(sqlMock.NewRows(columns).AddRow(1, 1, 10, model.OrderEventCreated, []byte(`{"id":3,"created_by":7}`), model.WebhookDeliveryStatusPending, 0, nil, nil, now, nil, now, now))
+					// the delivery of the second webhook already exists, nothing is returned.
+					sqlMock.ExpectQuery(insertQuery).WillReturnRows(sqlMock.NewRows(columns))
+					sqlMock.ExpectCommit()
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test skip webhook of another customer",
+				event:    model.OutboxEvent{ID: 13, AggregateType: model.OutboxAggregateOrder, AggregateID: 4, EventType: model.OrderEventCreated, Payload: []byte(`{"id":4,"created_by":9}`)},
+				mockFunc: func() {
+					// the webhook of customer 7 is left out by the owner filter.
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OrderEventCreated, int64(9), readAnyScopes).WillReturnRows(sqlMock.NewRows(webhookColumns))
+					sqlMock.ExpectCommit()
+				},
+			},
+			{
+				testType: "P",
+				testDesc: "test skip event of other aggregate",
+				event:    model.OutboxEvent{ID: 11, AggregateType: model.OutboxAggregateCar, AggregateID: 3, EventType: model.CarEventCreated, Payload: []byte(`{"id":3}`)},
+				mockFunc: func() {},
+			},
+			{
+				testType: "N",
+				testDesc: "test rollback when delivery can not be inserted",
+				event:    model.OutboxEvent{ID: 12, AggregateType: model.OutboxAggregateOrder, AggregateID: 3, EventType: model.OrderEventCreated, Payload: []byte(`{"id":3,"created_by":7}`)},
+				mockFunc: func() {
+					webhooks := sqlMock.NewRows(webhookColumns).
+						AddRow(1, "https://a.example.com", secret, "{order.created}", 7, model.CustomerScope, now, 7, now, nil, nil)
+					sqlMock.ExpectBegin()
+					sqlMock.ExpectQuery(selectQuery).WithArgs(model.OrderEventCreated, int64(7), readAnyScopes).WillReturnRows(webhooks)
+					sqlMock.ExpectQuery(insertQuery).WillReturnError(errors.New("connection reset"))
+					sqlMock.ExpectRollback()
+				},
+			},
+			{
+				testType: "N",
+				testDesc: "test reject order event without payload",
+				event:    model.OutboxEvent{ID: 14, AggregateType: model.OutboxAggregateOrder, AggregateID: 3, EventType: model.OrderEventCreated},
+				mockFunc: func() {},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				acc := webhook.WebhookDep{
+					Log:        logger.New(&logger.Config{}),
+					DB:         dbSQL,
+					Permission: policy.New(policy.Config{}),
+				}
+				test.mockFunc()
+				err := acc.Publish(context.Background(), test.event)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+				} else {
+					So(err, ShouldBeNil)
+				}
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
+
+func TestReplay(t *testing.T) {
+	dbSQL, sqlMock, err := gosqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	oldDB := boil.GetDB()
+	defer func() {
+		dbSQL.Close()
+		boil.SetDB(oldDB)
+	}()
+	boil.SetDB(dbSQL)
+
+	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	updateQuery := regexp.QuoteMeta("UPDATE \"webhook_deliveries\" SET")
+	whereQuery := regexp.QuoteMeta("WHERE (id=$8) AND (\"status\" IN ($9,$10));")
+	anyArg := gosqlmock.AnyArg()
+	acc := webhook.WebhookDep{
+		Log: logger.New(&logger.Config{}),
+		DB:  dbSQL,
+	}
+
+	Convey("test replay", t, FailureHalts, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			status   string
+			rows     int64
+			wantCode int
+		}{
+			{testType: "P", testDesc: "test replay failed delivery", status: model.WebhookDeliveryStatusFailed, rows: 1},
+			{testType: "P", testDesc: "test replay delivered delivery", status: model.WebhookDeliveryStatusDelivered, rows: 1},
+			{testType: "N", testDesc: "test refuse delivery claimed by a worker", status: model.WebhookDeliveryStatusPending, rows: 0, wantCode: svcerr.CodeDeliveryNotReplayable},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				delivery := &psqlmodel.WebhookDelivery{ID: 5, WebhookID: 1, Status: test.status, Attempts: 3}
+				// only a delivered or failed delivery is reset to pending.
+				sqlMock.ExpectExec(updateQuery+".*"+whereQuery).WithArgs(0, anyArg, anyArg, now, anyArg, model.WebhookDeliveryStatusPending, now, 5, model.WebhookDeliveryStatusDelivered, model.WebhookDeliveryStatusFailed).WillReturnResult(gosqlmock.NewResult(0, test.rows))
+
+				ctx := context.Background()
+				err := acc.Replay(&ctx, delivery, now)
+				if test.testType == "N" {
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+					So(delivery.Status, ShouldEqual, test.status)
+				} else {
+					So(err, ShouldBeNil)
+					So(delivery.Status, ShouldEqual, model.WebhookDeliveryStatusPending)
+					So(delivery.Attempts, ShouldEqual, 0)
+				}
+				So(sqlMock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		}
+	})
+}
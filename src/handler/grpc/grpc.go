@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase"
 )
@@ -23,12 +24,30 @@ type GRPCInterface interface {
 	GetCarByParam(ctx context.Context, v *grpcmodel.GetCarByParamRequest) (*grpcmodel.GetCarByParamReply, error)
 	DeleteCar(ctx context.Context, v *grpcmodel.DeleteCarRequest) (*grpcmodel.DeleteCarReply, error)
 	GetCarByID(ctx context.Context, v *grpcmodel.GetCarByIDRequest) (*grpcmodel.SingleCarReply, error)
+	GetAvailableCars(ctx context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error)
 
 	CreateOrder(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.SingleOrderReply, error)
 	UpdateOrder(ctx context.Context, v *grpcmodel.UpdateOrderRequest) (*grpcmodel.SingleOrderReply, error)
 	DeleteOrder(ctx context.Context, v *grpcmodel.DeleteOrderRequest) (*grpcmodel.DeleteOrderReply, error)
 	GetOrderByID(ctx context.Context, v *grpcmodel.GetOrderByIDRequest) (*grpcmodel.SingleOrderReply, error)
 	GetOrderByParam(ctx context.Context, v *grpcmodel.GetOrderByParamRequest) (*grpcmodel.GetOrderByParamReply, error)
+	WatchOrders(v *grpcmodel.GetOrderByParamRequest, stream grpcmodel.Order_WatchOrdersServer) error
+	QuoteOrder(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error)
+	ConfirmOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	PickupOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	ReturnOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	CompleteOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	CancelOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	ExtendOrder(ctx context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error)
+	GetOrderStatusHistory(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error)
+
+	CreateWebhook(ctx context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error)
+	GetWebhooks(ctx context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error)
+	DeleteWebhook(ctx context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error)
+	GetWebhookDeliveries(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error)
+	ReplayWebhookDelivery(ctx context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error)
+
+	GetAuditLogs(ctx context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error)
 }
 
 func New(conf Config, log *logger.Logger, usecase *usecase.UsecaseInterface) *GrpcDep {
@@ -83,6 +102,15 @@ func (g *GrpcDep) GetCarByID(ctx context.Context, v *grpcmodel.GetCarByIDRequest
 	return car, nil
 }
 
+func (g *GrpcDep) GetAvailableCars(ctx context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error) {
+	car, err := g.Usecase.Car.GetAvailableGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.GetCarByParamReply{}, err
+	}
+
+	return car, nil
+}
+
 func (g *GrpcDep) CreateOrder(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.SingleOrderReply, error) {
 	order, err := g.Usecase.Order.CreateGRPCProcess(&ctx, v)
 	if err != nil {
@@ -125,3 +153,134 @@ func (g *GrpcDep) GetOrderByParam(ctx context.Context, v *grpcmodel.GetOrderByPa
 
 	return order, nil
 }
+
+func (g *GrpcDep) WatchOrders(v *grpcmodel.GetOrderByParamRequest, stream grpcmodel.Order_WatchOrdersServer) error {
+	ctx := stream.Context()
+	return g.Usecase.Order.WatchGRPCProcess(&ctx, v, stream.Send)
+}
+
+func (g *GrpcDep) QuoteOrder(ctx context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error) {
+	quote, err := g.Usecase.Order.QuoteGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.QuoteOrderReply{}, err
+	}
+
+	return quote, nil
+}
+
+func (g *GrpcDep) ConfirmOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := g.Usecase.Order.UpdateStatusGRPCProcess(&ctx, model.OrderStatusConfirmed, v)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) PickupOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := g.Usecase.Order.UpdateStatusGRPCProcess(&ctx, model.OrderStatusPickedUp, v)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) ReturnOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := g.Usecase.Order.UpdateStatusGRPCProcess(&ctx, model.OrderStatusReturned, v)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) CompleteOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := g.Usecase.Order.UpdateStatusGRPCProcess(&ctx, model.OrderStatusCompleted, v)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) CancelOrder(ctx context.Context, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := g.Usecase.Order.UpdateStatusGRPCProcess(&ctx, model.OrderStatusCancelled, v)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) ExtendOrder(ctx context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error) {
+	order, err := g.Usecase.Order.ExtendGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, err
+	}
+
+	return order, nil
+}
+
+func (g *GrpcDep) GetOrderStatusHistory(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error) {
+	history, err := g.Usecase.Order.GetStatusHistoryGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.GetOrderStatusHistoryReply{}, err
+	}
+
+	return history, nil
+}
+
+func (g *GrpcDep) CreateWebhook(ctx context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error) {
+	webhook, err := g.Usecase.Webhook.CreateGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.SingleWebhookReply{}, err
+	}
+
+	return webhook, nil
+}
+
+func (g *GrpcDep) GetWebhooks(ctx context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error) {
+	webhooks, err := g.Usecase.Webhook.GetGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.GetWebhooksReply{}, err
+	}
+
+	return webhooks, nil
+}
+
+func (g *GrpcDep) DeleteWebhook(ctx context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error) {
+	webhook, err := g.Usecase.Webhook.DeleteByIDGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.DeleteWebhookReply{}, err
+	}
+
+	return webhook, nil
+}
+
+func (g *GrpcDep) GetWebhookDeliveries(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error) {
+	deliveries, err := g.Usecase.Webhook.GetDeliveriesGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.GetWebhookDeliveriesReply{}, err
+	}
+
+	return deliveries, nil
+}
+
+func (g *GrpcDep) ReplayWebhookDelivery(ctx context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error) {
+	delivery, err := g.Usecase.Webhook.ReplayDeliveryGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.WebhookDeliveryReply{}, err
+	}
+
+	return delivery, nil
+}
+
+func (g *GrpcDep) GetAuditLogs(ctx context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error) {
+	auditLogs, err := g.Usecase.Audit.GetByParamGRPCProcess(&ctx, v)
+	if err != nil {
+		return &grpcmodel.GetAuditLogsReply{}, err
+	}
+
+	return auditLogs, nil
+}
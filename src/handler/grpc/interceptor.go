@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
+	"google.golang.org/grpc"
+)
+
+// methodPermissions are the actions of every grpc method, one of which the
+// principal has to be permitted. Methods missing here are never permitted.
+var methodPermissions = map[string][]string{
+	grpcmodel.Order_CreateOrder_FullMethodName:           {model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny},
+	grpcmodel.Order_UpdateOrder_FullMethodName:           {model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny},
+	grpcmodel.Order_DeleteOrder_FullMethodName:           {model.PermissionOrderDelete},
+	grpcmodel.Order_GetOrderByID_FullMethodName:          {model.PermissionOrderReadOwn, model.PermissionOrderReadAny},
+	grpcmodel.Order_GetOrderByParam_FullMethodName:       {model.PermissionOrderReadOwn, model.PermissionOrderReadAny},
+	grpcmodel.Order_QuoteOrder_FullMethodName:            {model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny},
+	grpcmodel.Order_ConfirmOrder_FullMethodName:          {model.PermissionOrderTransition},
+	grpcmodel.Order_PickupOrder_FullMethodName:           {model.PermissionOrderTransition},
+	grpcmodel.Order_ReturnOrder_FullMethodName:           {model.PermissionOrderTransition},
+	grpcmodel.Order_CompleteOrder_FullMethodName:         {model.PermissionOrderTransition},
+	grpcmodel.Order_CancelOrder_FullMethodName:           {model.PermissionOrderCancel},
+	grpcmodel.Order_ExtendOrder_FullMethodName:           {model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny},
+	grpcmodel.Order_GetOrderStatusHistory_FullMethodName: {model.PermissionOrderReadOwn, model.PermissionOrderReadAny},
+	grpcmodel.Order_WatchOrders_FullMethodName:           {model.PermissionOrderReadOwn, model.PermissionOrderReadAny},
+	grpcmodel.Order_CreateCar_FullMethodName:             {model.PermissionCarWrite},
+	grpcmodel.Order_UpdateCar_FullMethodName:             {model.PermissionCarWrite},
+	grpcmodel.Order_DeleteCar_FullMethodName:             {model.PermissionCarWrite},
+	grpcmodel.Order_GetCarByID_FullMethodName:            {model.PermissionCarRead},
+	grpcmodel.Order_GetCarByParam_FullMethodName:         {model.PermissionCarRead},
+	grpcmodel.Order_GetAvailableCars_FullMethodName:      {model.PermissionCarRead},
+	grpcmodel.Order_CreateWebhook_FullMethodName:         {model.PermissionWebhookManage},
+	grpcmodel.Order_GetWebhooks_FullMethodName:           {model.PermissionWebhookManage},
+	grpcmodel.Order_DeleteWebhook_FullMethodName:         {model.PermissionWebhookManage},
+	grpcmodel.Order_GetWebhookDeliveries_FullMethodName:  {model.PermissionWebhookManage},
+	grpcmodel.Order_ReplayWebhookDelivery_FullMethodName: {model.PermissionWebhookManage},
+	grpcmodel.Order_GetAuditLogs_FullMethodName:          {model.PermissionAuditRead},
+}
+
+// UnaryMetadataInterceptor puts the principal of the token sent by the grpc
+// client and the request id and source signed by the rest server into the
+// context, both are verified with secret. Every change made by the call is
+// audited with them.
+func UnaryMetadataInterceptor(secret []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(model.NewIncomingContext(ctx, secret), req)
+	}
+}
+
+// StreamMetadataInterceptor is UnaryMetadataInterceptor for streams, the
+// principal of the token limits the orders a watch is sent.
+func StreamMetadataInterceptor(secret []byte) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: model.NewIncomingContext(ss.Context(), secret)})
+	}
+}
+
+// UnaryPermissionInterceptor rejects calls unless the principal of the token
+// sent by the client is permitted one of the actions of the method, the granted actions
+// are kept for the policy checks of the usecase.
+func UnaryPermissionInterceptor(permission *policy.Permission) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		principal, err := authorizeMethod(ctx, permission, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(model.WithPrincipal(ctx, principal), req)
+	}
+}
+
+// StreamPermissionInterceptor is UnaryPermissionInterceptor for streams.
+func StreamPermissionInterceptor(permission *policy.Permission) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		principal, err := authorizeMethod(ss.Context(), permission, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: model.WithPrincipal(ss.Context(), principal)})
+	}
+}
+
+func authorizeMethod(ctx context.Context, permission *policy.Permission, method string) (model.Principal, error) {
+	actions, ok := methodPermissions[method]
+	if !ok {
+		return model.Principal{}, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "method "+method+" is not permitted")
+	}
+
+	return permission.Authorize(ctx, actions...)
+}
+
+// contextServerStream is a server stream whose context is replaced by ctx.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *contextServerStream) Context() context.Context {
+	return c.ctx
+}
+
+// UnaryErrorInterceptor converts service errors into gRPC statuses carrying
+// their svcerr code. The debug error is logged with log, it is not sent to the
+// client.
+func UnaryErrorInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			log.Error(ctx, errormsg.WriteErr(err))
+			return resp, svcerr.ToGRPCStatus(err)
+		}
+		return resp, nil
+	}
+}
+
+// StreamErrorInterceptor is UnaryErrorInterceptor for errors ending a stream.
+func StreamErrorInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			log.Error(ss.Context(), errormsg.WriteErr(err))
+			return svcerr.ToGRPCStatus(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package rest
+
+import (
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/gin-gonic/gin"
+)
+
+// SignAudit signs the request id of the request and its rest source with
+// secret, the grpc service only audits a call with them once the signature
+// is verified.
+func SignAudit(secret []byte) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		info := model.AuditInfo{
+			RequestID: ctx.GetHeader(model.RequestIDMetadata),
+			Source:    model.AuditSourceREST,
+		}
+		ctx.Set(model.AuditSignatureKey, model.GetAuditSignature(secret, model.GetToken(ctx), info))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/audit"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type AuditDep struct {
+	log   logger.Logger
+	audit audit.AuditInterface
+	conf  Conf
+}
+
+type Conf struct{}
+
+type AuditInterface interface {
+	Read(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, a audit.AuditInterface) AuditInterface {
+	return &AuditDep{
+		conf:  conf,
+		log:   *log,
+		audit: a,
+	}
+}
+
+// Get Audit Logs godoc
+// @Summary Get audit logs
+// @Description Get the insert, update and delete log of cars and orders, newest first
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param entity_type query string false "search by entity type" Enums(car, order)
+// @Param entity_id query number false "search by entity id"
+// @Param action query string false "search by action" Enums(insert, update, delete)
+// @Param actor query number false "search by actor"
+// @Param request_id query string false "search by request id"
+// @Param source query string false "search by source" Enums(rest, grpc, worker)
+// @Param start_date query string false "search from created at in RFC3339 format"
+// @Param end_date query string false "search until created at in RFC3339 format"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} model.AuditLogsResponse
+// @Success 400 {object} model.AuditLogsResponse
+// @Success 500 {object} model.AuditLogsResponse
+// @Router /audit [get]
+func (a *AuditDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetAuditLogsByParam
+		response model.AuditLogsResponse
+	)
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error decode query"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	auditLogs, pagination, err := a.audit.GetByParam(ctx, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = auditLogs
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
@@ -29,6 +29,7 @@ type CarInterface interface {
 	Create(ctx *gin.Context)
 	UpdateByID(ctx *gin.Context)
 	Read(ctx *gin.Context)
+	ReadAvailable(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
 	DeleteByID(ctx *gin.Context)
 }
@@ -50,6 +51,7 @@ func New(conf Conf, log *logger.Logger, c car.CarInterface, validate *validator.
 // @Produce json
 // @Security OAuth2Password
 // @Param data body model.CreateCar true "Car Data"
+// @Param Idempotency-Key header string false "Key to safely retry the request, a repeated key returns the first result"
 // @Success 200 {object} model.SingleCarResponse
 // @Success 400 {object} model.SingleCarResponse
 // @Success 500 {object} model.SingleCarResponse
@@ -74,7 +76,7 @@ func (c *CarDep) Create(ctx *gin.Context) {
 		return
 	}
 
-	carInput.CreatedBy = ctx.Value("id").(int64)
+	carInput.IdempotencyKey = ctx.GetHeader(model.IdempotencyKeyHeader)
 	if err = c.validate.Struct(carInput); err != nil {
 		statusCode := response.Transform(ctx, c.log, http.StatusCreated, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
 		ctx.JSON(statusCode, response)
@@ -103,8 +105,12 @@ func (c *CarDep) Create(ctx *gin.Context) {
 // @Security OAuth2Password
 // @Param id path string true "update by id"
 // @Param data body model.UpdateCar true "Car Data"
+// @Param If-Match header string true "ETag of the car returned by get car"
 // @Success 200 {object} model.SingleCarResponse
+// @Header 200 {string} ETag "car version"
 // @Success 400 {object} model.SingleCarResponse
+// @Success 412 {object} model.SingleCarResponse
+// @Success 428 {object} model.SingleCarResponse
 // @Success 500 {object} model.SingleCarResponse
 // @Router /car/{id} [put]
 func (c *CarDep) UpdateByID(ctx *gin.Context) {
@@ -132,10 +138,11 @@ func (c *CarDep) UpdateByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	updateData.UpdatedBy = ctx.Value("id").(int64)
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	updateData.ExpectedVersion, err = model.ParseIfMatch(ctx.GetHeader(model.IfMatchHeader))
+	if err != nil {
+		statusCode := response.Transform(ctx, c.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
 	}
 	if err = c.validate.Struct(updateData); err != nil {
 		statusCode := response.Transform(ctx, c.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
@@ -150,6 +157,7 @@ func (c *CarDep) UpdateByID(ctx *gin.Context) {
 	}
 
 	response.Data = result
+	ctx.Header(model.ETagHeader, model.GetETag(result.Version))
 
 	statusCode := response.Transform(ctx, c.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
@@ -175,8 +183,11 @@ func (c *CarDep) UpdateByID(ctx *gin.Context) {
 // @Param month_rate_lt query number false "search by month rate less than"
 // @Param month_rate_lte query number false "search by month rate less than equal"
 // @Param image query string false "search by image"
+// @Param order_by query string false "comma separated columns, descending when prefixed by a minus" example(-day_rate,id)
 // @Param page query int false " "
 // @Param limit query int false " "
+// @Param page_mode query string false "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count" Enums(offset, cursor)
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, taken with the same order_by"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} model.CarsResponse
 // @Success 400 {object} model.CarsResponse
@@ -209,6 +220,61 @@ func (c *CarDep) Read(ctx *gin.Context) {
 	ctx.JSON(statusCode, response)
 }
 
+// Get Available Cars Data godoc
+// @Summary Get available cars data
+// @Description Get cars without any order overlapping the pickup and dropoff date
+// @Tags car
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param pickup_date query string true "pickup date" example(2024-02-02T00:00:00Z)
+// @Param dropoff_date query string true "dropoff date" example(2024-02-05T00:00:00Z)
+// @Param car_name query string false "search by car name"
+// @Param day_rate query number false "search by day rate"
+// @Param day_rate_gt query number false "search by day rate greater than"
+// @Param day_rate_gte query number false "search by day rate greater than equal"
+// @Param day_rate_lt query number false "search by day rate less than"
+// @Param day_rate_lte query number false "search by day rate less than equal"
+// @Param month_rate query number false "search by month rate"
+// @Param month_rate_gt query number false "search by month rate greater than"
+// @Param month_rate_gte query number false "search by month rate greater than equal"
+// @Param month_rate_lt query number false "search by month rate less than"
+// @Param month_rate_lte query number false "search by month rate less than equal"
+// @Param order_by query string false "comma separated columns, descending when prefixed by a minus" example(-day_rate,id)
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param page_mode query string false "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count" Enums(offset, cursor)
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, taken with the same order_by"
+// @Success 200 {object} model.CarsResponse
+// @Success 400 {object} model.CarsResponse
+// @Success 500 {object} model.CarsResponse
+// @Router /car/available [get]
+func (c *CarDep) ReadAvailable(ctx *gin.Context) {
+	var (
+		param    model.GetAvailableCarsByParam
+		response model.CarsResponse
+	)
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, c.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error decode query"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	cars, pagination, err := c.car.GetAvailable(ctx, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, c.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = cars
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, c.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
 // Get Cars Data godoc
 // @Summary Get cars data
 // @Description Get cars data
@@ -219,6 +285,7 @@ func (c *CarDep) Read(ctx *gin.Context) {
 // @Param id path string true "get by id"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} model.SingleCarResponse
+// @Header 200 {string} ETag "car version"
 // @Success 400 {object} model.SingleCarResponse
 // @Success 500 {object} model.SingleCarResponse
 // @Router /car/{id} [get]
@@ -239,6 +306,7 @@ func (c *CarDep) GetByID(ctx *gin.Context) {
 	}
 
 	response.Data = result
+	ctx.Header(model.ETagHeader, model.GetETag(result.Version))
 
 	statusCode := response.Transform(ctx, c.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
@@ -266,11 +334,7 @@ func (c *CarDep) DeleteByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
-	}
-	err = c.car.DeleteByID(ctx, ctx.Value("id").(int64), id)
+	err = c.car.DeleteByID(ctx, id)
 	if err != nil {
 		statusCode := response.Transform(ctx, c.log, http.StatusOK, err)
 		ctx.JSON(statusCode, response)
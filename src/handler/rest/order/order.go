@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
@@ -14,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/schema"
+	"github.com/volatiletech/null/v8"
 )
 
 type OrderDep struct {
@@ -23,14 +25,27 @@ type OrderDep struct {
 	validate *validator.Validate
 }
 
-type Conf struct{}
+type Conf struct {
+	// StreamHeartbeat is how often a heartbeat is sent on an idle order stream.
+	StreamHeartbeat time.Duration `mapstructure:"stream_heartbeat"`
+}
 
 type OrderInterface interface {
 	Create(ctx *gin.Context)
+	Quote(ctx *gin.Context)
 	UpdateByID(ctx *gin.Context)
 	Read(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
 	DeleteByID(ctx *gin.Context)
+	Confirm(ctx *gin.Context)
+	Pickup(ctx *gin.Context)
+	Return(ctx *gin.Context)
+	Complete(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+	Extend(ctx *gin.Context)
+	ReadOverdue(ctx *gin.Context)
+	GetStatusHistory(ctx *gin.Context)
+	Stream(ctx *gin.Context)
 }
 
 func New(conf Conf, log *logger.Logger, c order.OrderInterface, validate *validator.Validate) OrderInterface {
@@ -50,6 +65,7 @@ func New(conf Conf, log *logger.Logger, c order.OrderInterface, validate *valida
 // @Produce json
 // @Security OAuth2Password
 // @Param data body model.CreateOrder true "Order Data"
+// @Param Idempotency-Key header string false "Key to safely retry the request, a repeated key returns the first result"
 // @Success 200 {object} model.SingleOrderResponse
 // @Success 400 {object} model.SingleOrderResponse
 // @Success 500 {object} model.SingleOrderResponse
@@ -74,6 +90,7 @@ func (o *OrderDep) Create(ctx *gin.Context) {
 		return
 	}
 
+	orderInput.IdempotencyKey = ctx.GetHeader(model.IdempotencyKeyHeader)
 	if err = o.validate.Struct(orderInput); err != nil {
 		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
 		ctx.JSON(statusCode, response)
@@ -94,6 +111,58 @@ func (o *OrderDep) Create(ctx *gin.Context) {
 	ctx.JSON(statusCode, response)
 }
 
+// Quote Order godoc
+// @Summary Quote Order
+// @Description Preview order price without creating the order
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateOrder true "Order Data"
+// @Success 200 {object} model.QuoteResponse
+// @Success 400 {object} model.QuoteResponse
+// @Success 409 {object} model.QuoteResponse
+// @Success 500 {object} model.QuoteResponse
+// @Router /order/quote [post]
+func (o *OrderDep) Quote(ctx *gin.Context) {
+	var (
+		orderInput model.CreateOrder
+		response   model.QuoteResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &orderInput); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = o.validate.Struct(orderInput); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	orderInput.CreatedBy = ctx.Value("id").(int64)
+	result, err := o.order.Quote(ctx, orderInput)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
 // Update Order Data godoc
 // @Summary Update order data
 // @Description Update order data
@@ -103,8 +172,12 @@ func (o *OrderDep) Create(ctx *gin.Context) {
 // @Security OAuth2Password
 // @Param id path string true "update by id"
 // @Param data body model.UpdateOrder true "Order Data"
+// @Param If-Match header string true "ETag of the order returned by get order"
 // @Success 200 {object} model.SingleOrderResponse
+// @Header 200 {string} ETag "order version"
 // @Success 400 {object} model.SingleOrderResponse
+// @Success 412 {object} model.SingleOrderResponse
+// @Success 428 {object} model.SingleOrderResponse
 // @Success 500 {object} model.SingleOrderResponse
 // @Router /order/{id} [put]
 func (o *OrderDep) UpdateByID(ctx *gin.Context) {
@@ -132,10 +205,11 @@ func (o *OrderDep) UpdateByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	updateData.UpdatedBy = ctx.Value("id").(int64)
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	updateData.ExpectedVersion, err = model.ParseIfMatch(ctx.GetHeader(model.IfMatchHeader))
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
 	}
 	if err = o.validate.Struct(updateData); err != nil {
 		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
@@ -150,6 +224,7 @@ func (o *OrderDep) UpdateByID(ctx *gin.Context) {
 	}
 
 	response.Data = result
+	ctx.Header(model.ETagHeader, model.GetETag(result.Version))
 
 	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
@@ -173,8 +248,39 @@ func (o *OrderDep) UpdateByID(ctx *gin.Context) {
 // @Param dropoff_location query string false "search by dropoff location"
 // @Param dropoff_lat query string false "search by lat"
 // @Param dropoff_long query string false "search by long"
+// @Param start_date query string false "orders renting on or after this date"
+// @Param end_date query string false "orders renting on or before this date"
+// @Param order_date_from query string false "search by order date on or after" example(2024-02-01T00:00:00Z)
+// @Param order_date_to query string false "search by order date on or before" example(2024-02-29T00:00:00Z)
+// @Param pickup_date_from query string false "search by pickup date on or after"
+// @Param pickup_date_to query string false "search by pickup date on or before"
+// @Param dropoff_date_from query string false "search by dropoff date on or after"
+// @Param dropoff_date_to query string false "search by dropoff date on or before"
+// @Param created_at_from query string false "search by creation time on or after"
+// @Param created_at_to query string false "search by creation time on or before"
+// @Param car_id_in query []int false "search by any of car ids" collectionFormat(multi)
+// @Param status_in query []string false "search by any of statuses" collectionFormat(multi) Enums(pending, confirmed, picked_up, returned, completed, cancelled)
+// @Param pickup_near_lat query number false "search by pickup within the radius of this lat"
+// @Param pickup_near_long query number false "search by pickup within the radius of this long"
+// @Param pickup_radius_km query number false "radius in km around the pickup near point"
+// @Param pickup_min_lat query number false "search by pickup inside the box from this lat"
+// @Param pickup_max_lat query number false "search by pickup inside the box to this lat"
+// @Param pickup_min_long query number false "search by pickup inside the box from this long"
+// @Param pickup_max_long query number false "search by pickup inside the box to this long"
+// @Param dropoff_near_lat query number false "search by dropoff within the radius of this lat"
+// @Param dropoff_near_long query number false "search by dropoff within the radius of this long"
+// @Param dropoff_radius_km query number false "radius in km around the dropoff near point"
+// @Param dropoff_min_lat query number false "search by dropoff inside the box from this lat"
+// @Param dropoff_max_lat query number false "search by dropoff inside the box to this lat"
+// @Param dropoff_min_long query number false "search by dropoff inside the box from this long"
+// @Param dropoff_max_long query number false "search by dropoff inside the box to this long"
+// @Param order_by query string false "comma separated columns, descending when prefixed by a minus, pickup_distance and dropoff_distance need the near filter" example(-pickup_date,car_id)
 // @Param page query int false " "
 // @Param limit query int false " "
+// @Param page_mode query string false "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count" Enums(offset, cursor)
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, taken with the same order_by"
+// @Param include query string false "embed the car of every order" Enums(car)
+// @Param fields query string false "comma separated fields every order is limited to, the fields of the car are prefixed by car" example(id,status,car.car_name)
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} model.OrdersResponse
 // @Success 400 {object} model.OrdersResponse
@@ -202,6 +308,55 @@ func (o *OrderDep) Read(ctx *gin.Context) {
 
 	response.Data = orders
 	response.Pagination = pagination
+	response.Fields = param.GetFields()
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Overdue Orders Data godoc
+// @Summary Get overdue orders data
+// @Description Get picked up orders whose dropoff date has passed without a return
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param car_id query number false "search by car id"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param page_mode query string false "cursor pages by the next_cursor and prev_cursor of the pagination and skips the total count" Enums(offset, cursor)
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, taken with the same order_by"
+// @Param include query string false "embed the car of every order" Enums(car)
+// @Param fields query string false "comma separated fields every order is limited to, the fields of the car are prefixed by car" example(id,status,car.car_name)
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.OrdersResponse
+// @Success 400 {object} model.OrdersResponse
+// @Success 500 {object} model.OrdersResponse
+// @Router /order/overdue [get]
+func (o *OrderDep) ReadOverdue(ctx *gin.Context) {
+	var (
+		param    model.GetOrdersByParam
+		response model.OrdersResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+	param.IsOverdue = null.BoolFrom(true)
+	orders, pagination, err := o.order.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = orders
+	response.Pagination = pagination
+	response.Fields = param.GetFields()
 
 	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
@@ -217,6 +372,7 @@ func (o *OrderDep) Read(ctx *gin.Context) {
 // @Param id path string true "get by id"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} model.SingleOrderResponse
+// @Header 200 {string} ETag "order version"
 // @Success 400 {object} model.SingleOrderResponse
 // @Success 500 {object} model.SingleOrderResponse
 // @Router /order/{id} [get]
@@ -237,6 +393,7 @@ func (o *OrderDep) GetByID(ctx *gin.Context) {
 	}
 
 	response.Data = result
+	ctx.Header(model.ETagHeader, model.GetETag(result.Version))
 
 	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
@@ -244,7 +401,7 @@ func (o *OrderDep) GetByID(ctx *gin.Context) {
 
 // Delete Order Data godoc
 // @Summary Delete order data
-// @Description Delete order data
+// @Description Soft delete order data, restricted to super admin. Use cancel to cancel an order
 // @Tags order
 // @Accept json
 // @Produce json
@@ -264,17 +421,256 @@ func (o *OrderDep) DeleteByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	err = o.order.DeleteByID(ctx, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
 	}
-	err = o.order.DeleteByID(ctx, ctx.Value("id").(int64), id)
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Confirm Order godoc
+// @Summary Confirm order
+// @Description Move pending order to confirmed
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Success 200 {object} model.SingleOrderResponse
+// @Success 400 {object} model.SingleOrderResponse
+// @Success 409 {object} model.SingleOrderResponse
+// @Success 500 {object} model.SingleOrderResponse
+// @Router /order/{id}/confirm [post]
+func (o *OrderDep) Confirm(ctx *gin.Context) {
+	o.updateStatus(ctx, model.OrderStatusConfirmed)
+}
+
+// Pickup Order godoc
+// @Summary Pickup order
+// @Description Move confirmed order to picked up
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Success 200 {object} model.SingleOrderResponse
+// @Success 400 {object} model.SingleOrderResponse
+// @Success 409 {object} model.SingleOrderResponse
+// @Success 500 {object} model.SingleOrderResponse
+// @Router /order/{id}/pickup [post]
+func (o *OrderDep) Pickup(ctx *gin.Context) {
+	o.updateStatus(ctx, model.OrderStatusPickedUp)
+}
+
+// Return Order godoc
+// @Summary Return order
+// @Description Move picked up order to returned
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Success 200 {object} model.SingleOrderResponse
+// @Success 400 {object} model.SingleOrderResponse
+// @Success 409 {object} model.SingleOrderResponse
+// @Success 500 {object} model.SingleOrderResponse
+// @Router /order/{id}/return [post]
+func (o *OrderDep) Return(ctx *gin.Context) {
+	o.updateStatus(ctx, model.OrderStatusReturned)
+}
+
+// Complete Order godoc
+// @Summary Complete order
+// @Description Move returned order to completed
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Success 200 {object} model.SingleOrderResponse
+// @Success 400 {object} model.SingleOrderResponse
+// @Success 409 {object} model.SingleOrderResponse
+// @Success 500 {object} model.SingleOrderResponse
+// @Router /order/{id}/complete [post]
+func (o *OrderDep) Complete(ctx *gin.Context) {
+	o.updateStatus(ctx, model.OrderStatusCompleted)
+}
+
+// Cancel Order godoc
+// @Summary Cancel order
+// @Description Move pending or confirmed order to cancelled and charge the cancellation fee
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Param data body model.CancelOrder false "Cancellation Data"
+// @Success 200 {object} model.SingleOrderResponse
+// @Success 400 {object} model.SingleOrderResponse
+// @Success 409 {object} model.SingleOrderResponse
+// @Success 500 {object} model.SingleOrderResponse
+// @Router /order/{id}/cancel [post]
+func (o *OrderDep) Cancel(ctx *gin.Context) {
+	var (
+		cancelData model.CancelOrder
+		response   model.SingleOrderResponse
+	)
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, &cancelData); err != nil {
+			statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal body"))
+			ctx.JSON(statusCode, response)
+			return
+		}
+	}
+
+	if err = o.validate.Struct(cancelData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := o.order.CancelByID(ctx, id, cancelData)
 	if err != nil {
 		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
 		ctx.JSON(statusCode, response)
 		return
 	}
 
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Extend Order godoc
+// @Summary Extend order
+// @Description Move the dropoff date of an active order forward when the car is still available
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Param data body model.ExtendOrder true "Extension Data"
+// @Success 200 {object} model.OrderExtensionResponse
+// @Success 400 {object} model.OrderExtensionResponse
+// @Success 409 {object} model.OrderExtensionResponse
+// @Success 500 {object} model.OrderExtensionResponse
+// @Router /order/{id}/extend [post]
+func (o *OrderDep) Extend(ctx *gin.Context) {
+	var (
+		extendData model.ExtendOrder
+		response   model.OrderExtensionResponse
+	)
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &extendData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	if err = o.validate.Struct(extendData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := o.order.ExtendByID(ctx, id, extendData)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Order Status History godoc
+// @Summary Get order status history
+// @Description Get order status changes ordered from the oldest
+// @Tags order
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "order id"
+// @Success 200 {object} model.OrderStatusHistoryResponse
+// @Success 400 {object} model.OrderStatusHistoryResponse
+// @Success 500 {object} model.OrderStatusHistoryResponse
+// @Router /order/{id}/history [get]
+func (o *OrderDep) GetStatusHistory(ctx *gin.Context) {
+	var response model.OrderStatusHistoryResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := o.order.GetStatusHistory(ctx, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+func (o *OrderDep) updateStatus(ctx *gin.Context, status string) {
+	var response model.SingleOrderResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := o.order.UpdateStatusByID(ctx, id, status)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
 	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
 	ctx.JSON(statusCode, response)
 }
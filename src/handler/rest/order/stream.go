@@ -0,0 +1,143 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+	"github.com/volatiletech/null/v8"
+)
+
+// Stream Orders godoc
+// @Summary Stream order events
+// @Description Push order change events as server-sent events, the event id is the one to send back in Last-Event-ID to resume after a reconnect. A comment line is sent as heartbeat while no event happens.
+// @Tags order
+// @Accept json
+// @Produce text/event-stream
+// @Security OAuth2Password
+// @Param car_id query number false "search by car id"
+// @Param start_date query string false "orders renting on or after this date"
+// @Param end_date query string false "orders renting on or before this date"
+// @Param Last-Event-ID header string false "id of the last event received"
+// @Success 200 {object} model.OrderEvent
+// @Success 400 {object} model.EmptyResponse
+// @Router /order/stream [get]
+func (o *OrderDep) Stream(ctx *gin.Context) {
+	var (
+		param    model.WatchOrdersByParam
+		response model.EmptyResponse
+	)
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error decode query"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if lastEventID := ctx.GetHeader(model.LastEventIDHeader); lastEventID != "" {
+		id, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get last event id"))
+			ctx.JSON(statusCode, response)
+			return
+		}
+		param.LastEventID = null.Int64From(id)
+	}
+
+	err = param.Validate()
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	// everything is checked before the stream starts, an error can only be
+	// answered as json until then.
+	principal, ok := model.GetPrincipal(ctx)
+	if !ok {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "error get principal"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	heartbeat := o.conf.StreamHeartbeat
+	if heartbeat == 0 {
+		heartbeat = model.DefaultStreamHeartbeat
+	}
+
+	// the stream outlives the write timeout of the server.
+	err = http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		o.log.Warn(ctx, err)
+	}
+
+	// the watch runs on the request context, which has no gin keys, so the
+	// principal and its token are carried over for the grpc service to filter
+	// on.
+	streamCtx, cancel := context.WithCancel(model.WithToken(model.WithPrincipal(ctx.Request.Context(), principal), model.GetToken(ctx)))
+	defer cancel()
+
+	events := make(chan model.OrderEvent)
+	done := make(chan error, 1)
+	go func() {
+		done <- o.order.Watch(streamCtx, param, func(event model.OrderEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-streamCtx.Done():
+				return streamCtx.Err()
+			}
+		})
+	}()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-streamCtx.Done():
+			return
+		case err = <-done:
+			if err != nil {
+				o.log.Error(ctx, err)
+			}
+			return
+		case event := <-events:
+			err = writeEvent(ctx.Writer, event)
+		case <-ticker.C:
+			_, err = io.WriteString(ctx.Writer, ": heartbeat\n\n")
+		}
+
+		if err != nil {
+			o.log.Warn(ctx, err)
+			return
+		}
+		ctx.Writer.Flush()
+	}
+}
+
+func writeEvent(w io.Writer, event model.OrderEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.EventType, data)
+	return err
+}
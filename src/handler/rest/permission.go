@@ -0,0 +1,28 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission aborts the request unless the scope of its jwt is
+// permitted one of actions, the granted actions are kept for the policy
+// checks of the handler.
+func RequirePermission(log logger.Logger, permission *policy.Permission, actions ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, err := permission.Authorize(ctx, actions...)
+		if err != nil {
+			var response model.EmptyResponse
+			statusCode := response.Transform(ctx, log, http.StatusOK, err)
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+
+		ctx.Set(model.PermissionsKey, principal.Permissions)
+		ctx.Next()
+	}
+}
@@ -1,57 +1,85 @@
 package rest
 
 import (
-	"github.com/achwanyusuf/carrent-lib/pkg/httpserver"
 	"github.com/achwanyusuf/carrent-lib/pkg/jwt"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest/audit"
 	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest/car"
 	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest/order"
+	"github.com/achwanyusuf/carrent-ordersvc/src/handler/rest/webhook"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 )
 
 type RestDep struct {
-	Conf     Config
-	Log      *logger.Logger
-	Usecase  *usecase.UsecaseInterface
-	Gin      *gin.Engine
-	Validate *validator.Validate
+	Conf       Config
+	Log        *logger.Logger
+	Usecase    *usecase.UsecaseInterface
+	Gin        *gin.Engine
+	Validate   *validator.Validate
+	Permission *policy.Permission
 }
 
 type Config struct {
-	TokenSecret string     `mapstructure:"token_secret"`
-	Car         car.Conf   `mapstructure:"car"`
-	Order       order.Conf `mapstructure:"order"`
+	TokenSecret string       `mapstructure:"token_secret"`
+	Car         car.Conf     `mapstructure:"car"`
+	Order       order.Conf   `mapstructure:"order"`
+	Webhook     webhook.Conf `mapstructure:"webhook"`
+	Audit       audit.Conf   `mapstructure:"audit"`
 }
 
 type RestInterface struct {
-	car   car.CarInterface
-	order order.OrderInterface
+	car     car.CarInterface
+	order   order.OrderInterface
+	webhook webhook.WebhookInterface
+	audit   audit.AuditInterface
 }
 
 func New(r *RestDep) *RestInterface {
 	return &RestInterface{
 		car.New(r.Conf.Car, r.Log, r.Usecase.Car, r.Validate),
 		order.New(r.Conf.Order, r.Log, r.Usecase.Order, r.Validate),
+		webhook.New(r.Conf.Webhook, r.Log, r.Usecase.Webhook, r.Validate),
+		audit.New(r.Conf.Audit, r.Log, r.Usecase.Audit),
 	}
 }
 
 func (r *RestDep) Serve(handler *RestInterface) {
 	api := r.Gin.Group("/api")
-	api.Use(jwt.JWT(*r.Log, []byte(r.Conf.TokenSecret)))
+	api.Use(jwt.JWT(*r.Log, []byte(r.Conf.TokenSecret)), SignAudit([]byte(r.Conf.TokenSecret)))
 	{
-		api.POST("/car", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.car.Create)
-		api.PUT("/car/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.car.UpdateByID)
-		api.GET("/car", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.car.Read)
-		api.GET("/car/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.car.GetByID)
-		api.DELETE("/car/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.car.DeleteByID)
-
-		api.POST("/order", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.order.Create)
-		api.PUT("/order/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.order.UpdateByID)
-		api.GET("/order", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.order.Read)
-		api.GET("/order/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.order.GetByID)
-		api.DELETE("/order/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope, model.StoreScope, model.CustomerScope}), handler.order.DeleteByID)
+		api.POST("/car", RequirePermission(*r.Log, r.Permission, model.PermissionCarWrite), handler.car.Create)
+		api.PUT("/car/:id", RequirePermission(*r.Log, r.Permission, model.PermissionCarWrite), handler.car.UpdateByID)
+		api.GET("/car", RequirePermission(*r.Log, r.Permission, model.PermissionCarRead), handler.car.Read)
+		api.GET("/car/available", RequirePermission(*r.Log, r.Permission, model.PermissionCarRead), handler.car.ReadAvailable)
+		api.GET("/car/:id", RequirePermission(*r.Log, r.Permission, model.PermissionCarRead), handler.car.GetByID)
+		api.DELETE("/car/:id", RequirePermission(*r.Log, r.Permission, model.PermissionCarWrite), handler.car.DeleteByID)
+
+		api.POST("/order", RequirePermission(*r.Log, r.Permission, model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny), handler.order.Create)
+		api.POST("/order/quote", RequirePermission(*r.Log, r.Permission, model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny), handler.order.Quote)
+		api.PUT("/order/:id", RequirePermission(*r.Log, r.Permission, model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny), handler.order.UpdateByID)
+		api.GET("/order", RequirePermission(*r.Log, r.Permission, model.PermissionOrderReadOwn, model.PermissionOrderReadAny), handler.order.Read)
+		api.GET("/order/stream", RequirePermission(*r.Log, r.Permission, model.PermissionOrderReadOwn, model.PermissionOrderReadAny), handler.order.Stream)
+		api.GET("/order/overdue", RequirePermission(*r.Log, r.Permission, model.PermissionOrderReadAny), handler.order.ReadOverdue)
+		api.GET("/order/:id", RequirePermission(*r.Log, r.Permission, model.PermissionOrderReadOwn, model.PermissionOrderReadAny), handler.order.GetByID)
+		api.DELETE("/order/:id", RequirePermission(*r.Log, r.Permission, model.PermissionOrderDelete), handler.order.DeleteByID)
+		api.POST("/order/:id/confirm", RequirePermission(*r.Log, r.Permission, model.PermissionOrderTransition), handler.order.Confirm)
+		api.POST("/order/:id/pickup", RequirePermission(*r.Log, r.Permission, model.PermissionOrderTransition), handler.order.Pickup)
+		api.POST("/order/:id/return", RequirePermission(*r.Log, r.Permission, model.PermissionOrderTransition), handler.order.Return)
+		api.POST("/order/:id/complete", RequirePermission(*r.Log, r.Permission, model.PermissionOrderTransition), handler.order.Complete)
+		api.GET("/order/:id/history", RequirePermission(*r.Log, r.Permission, model.PermissionOrderReadOwn, model.PermissionOrderReadAny), handler.order.GetStatusHistory)
+		api.POST("/order/:id/cancel", RequirePermission(*r.Log, r.Permission, model.PermissionOrderCancel), handler.order.Cancel)
+		api.POST("/order/:id/extend", RequirePermission(*r.Log, r.Permission, model.PermissionOrderWriteOwn, model.PermissionOrderWriteAny), handler.order.Extend)
+
+		api.POST("/webhook", RequirePermission(*r.Log, r.Permission, model.PermissionWebhookManage), handler.webhook.Create)
+		api.GET("/webhook", RequirePermission(*r.Log, r.Permission, model.PermissionWebhookManage), handler.webhook.Read)
+		api.DELETE("/webhook/:id", RequirePermission(*r.Log, r.Permission, model.PermissionWebhookManage), handler.webhook.DeleteByID)
+		api.GET("/webhook/:id/delivery", RequirePermission(*r.Log, r.Permission, model.PermissionWebhookManage), handler.webhook.ReadDeliveries)
+		api.POST("/webhook/:id/delivery/:delivery_id/replay", RequirePermission(*r.Log, r.Permission, model.PermissionWebhookManage), handler.webhook.ReplayDelivery)
+
+		api.GET("/audit", RequirePermission(*r.Log, r.Permission, model.PermissionAuditRead), handler.audit.Read)
 	}
 }
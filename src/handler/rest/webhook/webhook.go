@@ -0,0 +1,246 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/webhook"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/schema"
+)
+
+type WebhookDep struct {
+	log      logger.Logger
+	webhook  webhook.WebhookInterface
+	conf     Conf
+	validate *validator.Validate
+}
+
+type Conf struct{}
+
+type WebhookInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	DeleteByID(ctx *gin.Context)
+	ReadDeliveries(ctx *gin.Context)
+	ReplayDelivery(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, w webhook.WebhookInterface, validate *validator.Validate) WebhookInterface {
+	return &WebhookDep{
+		conf:     conf,
+		log:      *log,
+		webhook:  w,
+		validate: validate,
+	}
+}
+
+// Create Webhook godoc
+// @Summary Create Webhook
+// @Description Subscribe an url to order events, deliveries are signed with the returned secret
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateWebhook true "Webhook Data"
+// @Success 200 {object} model.SingleWebhookResponse
+// @Success 400 {object} model.SingleWebhookResponse
+// @Success 500 {object} model.SingleWebhookResponse
+// @Router /webhook [post]
+func (w *WebhookDep) Create(ctx *gin.Context) {
+	var (
+		webhookInput model.CreateWebhook
+		response     model.SingleWebhookResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusCreated, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &webhookInput); err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusCreated, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = w.validate.Struct(webhookInput); err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusCreated, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error validate struct"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := w.webhook.Create(ctx, webhookInput)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, w.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Webhooks godoc
+// @Summary Get webhooks
+// @Description Get webhooks created by the caller
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Success 200 {object} model.WebhooksResponse
+// @Success 400 {object} model.WebhooksResponse
+// @Success 500 {object} model.WebhooksResponse
+// @Router /webhook [get]
+func (w *WebhookDep) Read(ctx *gin.Context) {
+	var response model.WebhooksResponse
+	result, err := w.webhook.Get(ctx)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, w.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Delete Webhook godoc
+// @Summary Delete webhook
+// @Description Delete webhook created by the caller, its pending deliveries are not sent
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "webhook id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 404 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /webhook/{id} [delete]
+func (w *WebhookDep) DeleteByID(ctx *gin.Context) {
+	var response model.EmptyResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	err = w.webhook.DeleteByID(ctx, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, w.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Webhook Deliveries godoc
+// @Summary Get webhook deliveries
+// @Description Get delivery log of a webhook created by the caller, newest first
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "webhook id"
+// @Param status query string false "search by status" Enums(pending, delivered, failed)
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} model.WebhookDeliveriesResponse
+// @Success 400 {object} model.WebhookDeliveriesResponse
+// @Success 404 {object} model.WebhookDeliveriesResponse
+// @Success 500 {object} model.WebhookDeliveriesResponse
+// @Router /webhook/{id}/delivery [get]
+func (w *WebhookDep) ReadDeliveries(ctx *gin.Context) {
+	var (
+		param    model.GetWebhookDeliveriesByParam
+		response model.WebhookDeliveriesResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	var decoder = schema.NewDecoder()
+	err = decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error decode query"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	param.WebhookID = id
+	deliveries, pagination, err := w.webhook.GetDeliveries(ctx, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = deliveries
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, w.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Replay Webhook Delivery godoc
+// @Summary Replay webhook delivery
+// @Description Send a delivery of a webhook created by the caller again with a fresh set of attempts
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "webhook id"
+// @Param delivery_id path string true "delivery id"
+// @Success 200 {object} model.SingleWebhookDeliveryResponse
+// @Success 400 {object} model.SingleWebhookDeliveryResponse
+// @Success 404 {object} model.SingleWebhookDeliveryResponse
+// @Success 500 {object} model.SingleWebhookDeliveryResponse
+// @Router /webhook/{id}/delivery/{delivery_id}/replay [post]
+func (w *WebhookDep) ReplayDelivery(ctx *gin.Context) {
+	var response model.SingleWebhookDeliveryResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	deliveryID, err := strconv.ParseInt(ctx.Param("delivery_id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get delivery id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := w.webhook.ReplayDelivery(ctx, id, deliveryID)
+	if err != nil {
+		statusCode := response.Transform(ctx, w.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, w.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase"
+)
+
+const (
+	// defaultOverdueInterval is used when overdue_interval is not configured.
+	defaultOverdueInterval = time.Hour
+	// defaultOutboxInterval is used when outbox_interval is not configured.
+	defaultOutboxInterval = 5 * time.Second
+	// defaultWebhookInterval is used when webhook_interval is not configured.
+	defaultWebhookInterval = 5 * time.Second
+)
+
+type WorkerDep struct {
+	Conf    Config
+	Log     logger.Logger
+	Usecase *usecase.UsecaseInterface
+}
+
+type Config struct {
+	OverdueInterval time.Duration `mapstructure:"overdue_interval"`
+	OutboxInterval  time.Duration `mapstructure:"outbox_interval"`
+	WebhookInterval time.Duration `mapstructure:"webhook_interval"`
+}
+
+func New(conf Config, log *logger.Logger, usecase *usecase.UsecaseInterface) *WorkerDep {
+	return &WorkerDep{
+		Conf:    conf,
+		Log:     *log,
+		Usecase: usecase,
+	}
+}
+
+// Run processes overdue orders, relays outbox events and sends webhook
+// deliveries once on start and then on their own interval until ctx is done.
+func (w *WorkerDep) Run(ctx context.Context) {
+	ctx = model.WithAuditInfo(ctx, model.AuditInfo{
+		Source: model.AuditSourceWorker,
+	})
+
+	overdueInterval := w.Conf.OverdueInterval
+	if overdueInterval <= 0 {
+		overdueInterval = defaultOverdueInterval
+	}
+
+	outboxInterval := w.Conf.OutboxInterval
+	if outboxInterval <= 0 {
+		outboxInterval = defaultOutboxInterval
+	}
+
+	webhookInterval := w.Conf.WebhookInterval
+	if webhookInterval <= 0 {
+		webhookInterval = defaultWebhookInterval
+	}
+
+	overdueTicker := time.NewTicker(overdueInterval)
+	defer overdueTicker.Stop()
+
+	outboxTicker := time.NewTicker(outboxInterval)
+	defer outboxTicker.Stop()
+
+	webhookTicker := time.NewTicker(webhookInterval)
+	defer webhookTicker.Stop()
+
+	w.processOverdue(ctx)
+	w.relayOutbox(ctx)
+	w.deliverWebhook(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-overdueTicker.C:
+			w.processOverdue(ctx)
+		case <-outboxTicker.C:
+			w.relayOutbox(ctx)
+		case <-webhookTicker.C:
+			w.deliverWebhook(ctx)
+		}
+	}
+}
+
+func (w *WorkerDep) processOverdue(ctx context.Context) {
+	err := w.Usecase.Order.ProcessOverdue(&ctx)
+	if err != nil {
+		w.Log.Error(ctx, err, "error process overdue orders")
+	}
+}
+
+func (w *WorkerDep) relayOutbox(ctx context.Context) {
+	err := w.Usecase.Outbox.Relay(&ctx)
+	if err != nil {
+		w.Log.Error(ctx, err, "error relay outbox events")
+	}
+}
+
+func (w *WorkerDep) deliverWebhook(ctx context.Context) {
+	err := w.Usecase.Webhook.Deliver(&ctx)
+	if err != nil {
+		w.Log.Error(ctx, err, "error deliver webhooks")
+	}
+}
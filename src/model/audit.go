@@ -0,0 +1,326 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	AuditEntityCar   = "car"
+	AuditEntityOrder = "order"
+
+	AuditActionInsert = "insert"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+
+	AuditSourceREST   = "rest"
+	AuditSourceGRPC   = "grpc"
+	AuditSourceWorker = "worker"
+
+	// AuditActorSystem is the actor of the changes made by the service itself,
+	// such as the late fee set by the worker.
+	AuditActorSystem = 0
+)
+
+// AuditInfo tells where a mutation comes from, it travels in the context.
+type AuditInfo struct {
+	RequestID string
+	Source    string
+}
+
+type auditInfoKey struct{}
+
+func WithAuditInfo(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+func GetAuditInfo(ctx context.Context) AuditInfo {
+	info, _ := ctx.Value(auditInfoKey{}).(AuditInfo)
+	return info
+}
+
+// AuditChange is the old and new value of a changed field.
+type AuditChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// GetAuditDiff returns the fields whose json value differs between before and
+// after, either of which may be nil for an insert or a hard delete.
+func GetAuditDiff(before, after interface{}) (map[string]AuditChange, error) {
+	oldFields, err := getAuditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	newFields, err := getAuditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(map[string]AuditChange)
+	for key, oldValue := range oldFields {
+		newValue := newFields[key]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diff[key] = AuditChange{Old: oldValue, New: newValue}
+		}
+	}
+
+	for key, newValue := range newFields {
+		if _, ok := oldFields[key]; !ok && newValue != nil {
+			diff[key] = AuditChange{New: newValue}
+		}
+	}
+
+	return diff, nil
+}
+
+func getAuditFields(v interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if rv := reflect.ValueOf(v); !rv.IsValid() || rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
+
+// NewAuditLog returns the audit row of a mutation on the given entity made by
+// actor, the source and request id are taken from ctx.
+func NewAuditLog(ctx context.Context, entityType string, entityID int, action string, actor int, before, after interface{}) (*psqlmodel.AuditLog, error) {
+	diff, err := GetAuditDiff(before, after)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get audit diff")
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal audit diff")
+	}
+
+	info := GetAuditInfo(ctx)
+	return &psqlmodel.AuditLog{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Actor:      actor,
+		Diff:       data,
+		RequestID:  null.NewString(info.RequestID, info.RequestID != ""),
+		Source:     info.Source,
+	}, nil
+}
+
+type AuditLog struct {
+	ID         int64                  `json:"id"`
+	EntityType string                 `json:"entity_type"`
+	EntityID   int64                  `json:"entity_id"`
+	Action     string                 `json:"action"`
+	Actor      int64                  `json:"actor"`
+	Diff       map[string]AuditChange `json:"diff"`
+	RequestID  *string                `json:"request_id,omitempty"`
+	Source     string                 `json:"source"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+type GetAuditLogsByParam struct {
+	EntityType null.String `schema:"entity_type" json:"entity_type"`
+	EntityID   null.Int64  `schema:"entity_id" json:"entity_id"`
+	Action     null.String `schema:"action" json:"action"`
+	Actor      null.Int64  `schema:"actor" json:"actor"`
+	RequestID  null.String `schema:"request_id" json:"request_id"`
+	Source     null.String `schema:"source" json:"source"`
+	StartDate  null.Time   `schema:"start_date" json:"start_date"`
+	EndDate    null.Time   `schema:"end_date" json:"end_date"`
+	Limit      int64       `schema:"limit" json:"limit"`
+	Page       int64       `schema:"page" json:"page"`
+}
+
+func (g *GetAuditLogsByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.EntityType.Valid {
+		res = append(res, qm.Where("entity_type=?", g.EntityType.String))
+	}
+
+	if g.EntityID.Valid {
+		res = append(res, qm.Where("entity_id=?", g.EntityID.Int64))
+	}
+
+	if g.Action.Valid {
+		res = append(res, qm.Where("action=?", g.Action.String))
+	}
+
+	if g.Actor.Valid {
+		res = append(res, qm.Where("actor=?", g.Actor.Int64))
+	}
+
+	if g.RequestID.Valid {
+		res = append(res, qm.Where("request_id=?", g.RequestID.String))
+	}
+
+	if g.Source.Valid {
+		res = append(res, qm.Where("source=?", g.Source.String))
+	}
+
+	if g.StartDate.Valid {
+		res = append(res, qm.Where("created_at>=?", g.StartDate.Time))
+	}
+
+	if g.EndDate.Valid {
+		res = append(res, qm.Where("created_at<=?", g.EndDate.Time))
+	}
+
+	return res
+}
+
+func (g *GetAuditLogsByParam) FillGrpcClient() *grpcmodel.GetAuditLogsRequest {
+	res := &grpcmodel.GetAuditLogsRequest{
+		EntityType: g.EntityType.Ptr(),
+		EntityId:   g.EntityID.Ptr(),
+		Action:     g.Action.Ptr(),
+		Actor:      g.Actor.Ptr(),
+		RequestId:  g.RequestID.Ptr(),
+		Source:     g.Source.Ptr(),
+		Limit:      g.Limit,
+		Page:       g.Page,
+	}
+
+	if g.StartDate.Valid {
+		startDate := g.StartDate.Time.Format(time.RFC3339)
+		res.StartDate = &startDate
+	}
+
+	if g.EndDate.Valid {
+		endDate := g.EndDate.Time.Format(time.RFC3339)
+		res.EndDate = &endDate
+	}
+
+	return res
+}
+
+func TransformGetAuditLogsRequestToParam(ctx context.Context, v *grpcmodel.GetAuditLogsRequest, log logger.Logger) GetAuditLogsByParam {
+	var (
+		startDate null.Time
+		endDate   null.Time
+	)
+	if v.StartDate != nil {
+		sDate, err := time.Parse(time.RFC3339, *v.StartDate)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		startDate = null.TimeFrom(sDate)
+	}
+	if v.EndDate != nil {
+		eDate, err := time.Parse(time.RFC3339, *v.EndDate)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		endDate = null.TimeFrom(eDate)
+	}
+	return GetAuditLogsByParam{
+		EntityType: null.StringFromPtr(v.EntityType),
+		EntityID:   null.Int64FromPtr(v.EntityId),
+		Action:     null.StringFromPtr(v.Action),
+		Actor:      null.Int64FromPtr(v.Actor),
+		RequestID:  null.StringFromPtr(v.RequestId),
+		Source:     null.StringFromPtr(v.Source),
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Limit:      v.Limit,
+		Page:       v.Page,
+	}
+}
+
+func TransformAuditLogReply(v *psqlmodel.AuditLog) *grpcmodel.AuditLogReply {
+	return &grpcmodel.AuditLogReply{
+		Id:         int64(v.ID),
+		EntityType: v.EntityType,
+		EntityId:   int64(v.EntityID),
+		Action:     v.Action,
+		Actor:      int64(v.Actor),
+		Diff:       string(v.Diff),
+		RequestId:  v.RequestID.Ptr(),
+		Source:     v.Source,
+		CreatedAt:  v.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func TransformAuditLogsReply(v *psqlmodel.AuditLogSlice, p Pagination) *grpcmodel.GetAuditLogsReply {
+	var data []*grpcmodel.AuditLogReply
+	for _, val := range *v {
+		data = append(data, TransformAuditLogReply(val))
+	}
+
+	return &grpcmodel.GetAuditLogsReply{
+		Data: data,
+		Pagination: &grpcmodel.Pagination{
+			CurrentPage:    p.CurrentPage,
+			CurrentElement: p.CurrentElements,
+			TotalPages:     p.TotalPages,
+			TotalElements:  p.TotalElements,
+			SortBy:         p.SortBy,
+		},
+	}
+}
+
+func TransformAuditLogReplyToAuditLog(ctx context.Context, v *grpcmodel.AuditLogReply, log logger.Logger) AuditLog {
+	createdAt, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	var diff map[string]AuditChange
+	err = json.Unmarshal([]byte(v.Diff), &diff)
+	if err != nil {
+		log.Error(ctx, err, "error unmarshal audit diff")
+	}
+
+	return AuditLog{
+		ID:         v.Id,
+		EntityType: v.EntityType,
+		EntityID:   v.EntityId,
+		Action:     v.Action,
+		Actor:      v.Actor,
+		Diff:       diff,
+		RequestID:  v.RequestId,
+		Source:     v.Source,
+		CreatedAt:  createdAt,
+	}
+}
+
+func TransformAuditLogsReplyToAuditLogs(ctx context.Context, v *grpcmodel.GetAuditLogsReply, log logger.Logger) ([]AuditLog, Pagination) {
+	var (
+		auditLogs  []AuditLog
+		pagination Pagination
+	)
+
+	for _, val := range v.Data {
+		auditLogs = append(auditLogs, TransformAuditLogReplyToAuditLog(ctx, val, log))
+	}
+
+	if v.Pagination != nil {
+		pagination = Pagination{
+			CurrentPage:     v.Pagination.CurrentPage,
+			CurrentElements: v.Pagination.CurrentElement,
+			TotalPages:      v.Pagination.TotalPages,
+			TotalElements:   v.Pagination.TotalElements,
+			SortBy:          v.Pagination.SortBy,
+		}
+	}
+
+	return auditLogs, pagination
+}
@@ -0,0 +1,115 @@
+package model_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetAuditDiff(t *testing.T) {
+	Convey("test get audit diff", t, func() {
+		before := &psqlmodel.Car{ID: 1, CarName: "sedan", DayRate: 100, Version: 1}
+		after := &psqlmodel.Car{ID: 1, CarName: "sedan", DayRate: 150, Version: 2}
+
+		Convey("0 - [P] : test update keeps changed fields only", func() {
+			diff, err := model.GetAuditDiff(before, after)
+			So(err, ShouldBeNil)
+			So(len(diff), ShouldEqual, 2)
+			So(diff["day_rate"], ShouldResemble, model.AuditChange{Old: float64(100), New: float64(150)})
+			So(diff["version"], ShouldResemble, model.AuditChange{Old: float64(1), New: float64(2)})
+		})
+
+		Convey("1 - [P] : test insert has no old value", func() {
+			var none *psqlmodel.Car
+			diff, err := model.GetAuditDiff(none, after)
+			So(err, ShouldBeNil)
+			So(diff["car_name"], ShouldResemble, model.AuditChange{New: "sedan"})
+			So(diff["id"].Old, ShouldBeNil)
+		})
+
+		Convey("2 - [P] : test hard delete has no new value", func() {
+			diff, err := model.GetAuditDiff(before, nil)
+			So(err, ShouldBeNil)
+			So(diff["car_name"], ShouldResemble, model.AuditChange{Old: "sedan"})
+		})
+
+		Convey("3 - [P] : test unchanged has empty diff", func() {
+			diff, err := model.GetAuditDiff(before, before)
+			So(err, ShouldBeNil)
+			So(diff, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestNewAuditLog(t *testing.T) {
+	Convey("test new audit log", t, func() {
+		ctx := model.WithAuditInfo(context.Background(), model.AuditInfo{RequestID: "req-1", Source: model.AuditSourceREST})
+		after := &psqlmodel.Order{ID: 3, CarID: 7}
+
+		audit, err := model.NewAuditLog(ctx, model.AuditEntityOrder, after.ID, model.AuditActionInsert, 9, nil, after)
+		So(err, ShouldBeNil)
+		So(audit.EntityType, ShouldEqual, model.AuditEntityOrder)
+		So(audit.EntityID, ShouldEqual, 3)
+		So(audit.Actor, ShouldEqual, 9)
+		So(audit.RequestID.String, ShouldEqual, "req-1")
+		So(audit.Source, ShouldEqual, model.AuditSourceREST)
+
+		var diff map[string]model.AuditChange
+		So(json.Unmarshal(audit.Diff, &diff), ShouldBeNil)
+		So(diff["car_id"].New, ShouldEqual, float64(7))
+
+		audit, err = model.NewAuditLog(context.Background(), model.AuditEntityOrder, after.ID, model.AuditActionInsert, 9, nil, after)
+		So(err, ShouldBeNil)
+		So(audit.RequestID.Valid, ShouldBeFalse)
+	})
+}
+
+func TestAuditMetadata(t *testing.T) {
+	secret := []byte("s3cr3t")
+	Convey("test audit info travel as grpc metadata", t, func() {
+		newRequest := func() *gin.Context {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/api/car", nil)
+			c.Request.Header.Set("X-Request-ID", "req-1")
+			c.Request.Header.Set("Authorization", "Bearer token-1")
+			return c
+		}
+
+		Convey("0 - [P] : test signed rest request id sent to grpc service", func() {
+			c := newRequest()
+			c.Set(model.AuditSignatureKey, model.GetAuditSignature(secret, "token-1", model.AuditInfo{RequestID: "req-1", Source: model.AuditSourceREST}))
+
+			out, _ := metadata.FromOutgoingContext(model.NewOutgoingContext(c))
+			info := model.GetAuditInfo(model.NewIncomingContext(metadata.NewIncomingContext(context.Background(), out), secret))
+			So(info, ShouldResemble, model.AuditInfo{RequestID: "req-1", Source: model.AuditSourceREST})
+		})
+
+		Convey("1 - [N] : test unsigned source sent by grpc client is dropped", func() {
+			md := metadata.Pairs(model.RequestIDMetadata, "req-2", model.SourceMetadata, model.AuditSourceWorker)
+			info := model.GetAuditInfo(model.NewIncomingContext(metadata.NewIncomingContext(context.Background(), md), secret))
+			So(info, ShouldResemble, model.AuditInfo{Source: model.AuditSourceGRPC})
+		})
+
+		Convey("2 - [N] : test signature of another token is dropped", func() {
+			c := newRequest()
+			c.Set(model.AuditSignatureKey, model.GetAuditSignature(secret, "token-2", model.AuditInfo{RequestID: "req-1", Source: model.AuditSourceREST}))
+
+			out, _ := metadata.FromOutgoingContext(model.NewOutgoingContext(c))
+			info := model.GetAuditInfo(model.NewIncomingContext(metadata.NewIncomingContext(context.Background(), out), secret))
+			So(info, ShouldResemble, model.AuditInfo{Source: model.AuditSourceGRPC})
+		})
+
+		Convey("3 - [P] : test call without metadata made by grpc client", func() {
+			info := model.GetAuditInfo(model.NewIncomingContext(context.Background(), secret))
+			So(info, ShouldResemble, model.AuditInfo{Source: model.AuditSourceGRPC})
+		})
+	})
+}
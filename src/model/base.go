@@ -9,6 +9,7 @@ import (
 var (
 	DefaultRedisExpiration time.Duration = 5 * time.Minute
 	DefaultPageLimit                     = 10
+	DefaultFlightTimeout   time.Duration = 10 * time.Second
 	MustRevalidate                       = "must-revalidate"
 	SuperAdminScope        string        = "sup"
 	StoreScope             string        = "sto"
@@ -30,4 +31,6 @@ type Pagination struct {
 	TotalPages      int64  `json:"total_pages"`
 	TotalElements   int64  `json:"total_elements"`
 	SortBy          string `json:"sort_by"`
+	NextCursor      string `json:"next_cursor,omitempty"`
+	PrevCursor      string `json:"prev_cursor,omitempty"`
 }
@@ -1,7 +1,6 @@
 package model
 
 import (
-	"strings"
 	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
@@ -13,9 +12,13 @@ import (
 )
 
 var (
-	GetSingleByParamCarKey string = "gspCar:%s"
-	GetByParamCarKey       string = "gpCar:%s"
-	GetByParamCarPgKey     string = "gppgCar:%s"
+	GetSingleByParamCarKey string = "gspCar:%d:%s"
+	GetByParamCarKey       string = "gpCar:%d:%s"
+	GetByParamCarPgKey     string = "gppgCar:%d:%s"
+
+	// CarCacheNamespaceKey holds the version of every cached car entry, it
+	// is part of each cache key so bumping it bypasses all of them at once.
+	CarCacheNamespaceKey string = "nsCar"
 )
 
 type GetCarByParam struct {
@@ -49,19 +52,19 @@ func (g *GetCarByParam) GetQuery() []qm.QueryMod {
 	}
 
 	if g.DayRateGT.Valid {
-		res = append(res, qm.Where("day_rate >?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate >?", g.DayRateGT.Float64))
 	}
 
 	if g.DayRateGTE.Valid {
-		res = append(res, qm.Where("day_rate >=?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate >=?", g.DayRateGTE.Float64))
 	}
 
 	if g.DayRateLT.Valid {
-		res = append(res, qm.Where("day_rate <?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate <?", g.DayRateLT.Float64))
 	}
 
 	if g.DayRateLTE.Valid {
-		res = append(res, qm.Where("day_rate <=?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate <=?", g.DayRateLTE.Float64))
 	}
 
 	if g.MonthRate.Valid {
@@ -93,9 +96,11 @@ func (g *GetCarByParam) GetQuery() []qm.QueryMod {
 
 type GetCarsByParam struct {
 	GetCarByParam
-	OrderBy null.String `schema:"order_by" json:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit"`
-	Page    int64       `schema:"page" json:"page"`
+	OrderBy  null.String `schema:"order_by" json:"order_by"`
+	Limit    int64       `schema:"limit" json:"limit"`
+	Page     int64       `schema:"page" json:"page"`
+	PageMode null.String `schema:"page_mode" json:"page_mode"`
+	Cursor   null.String `schema:"cursor" json:"cursor"`
 }
 
 func (g *GetCarsByParam) FillGrpcClient() *grpcmodel.GetCarByParamRequest {
@@ -116,7 +121,39 @@ func (g *GetCarsByParam) FillGrpcClient() *grpcmodel.GetCarByParamRequest {
 		OrderBy:      g.OrderBy.Ptr(),
 		Limit:        g.Limit,
 		Page:         g.Page,
+		PageMode:     g.PageMode.Ptr(),
+		Cursor:       g.Cursor.Ptr(),
+	}
+}
+
+// Validate returns an error when the order by of g is not a valid sort or
+// its cursor is not of that sort.
+func (g *GetCarsByParam) Validate() error {
+	err := validatePageMode(g.PageMode)
+	if err != nil {
+		return err
+	}
+
+	if g.IsCursor() {
+		_, err = g.GetCursorPage()
+		return err
+	}
+
+	if !g.OrderBy.Valid {
+		return nil
 	}
+
+	_, err = ParseSort(g.OrderBy.String, CarSortColumns)
+	return err
+}
+
+// IsCursor reports whether g is paged by cursor, which a cursor implies.
+func (g *GetCarsByParam) IsCursor() bool {
+	return g.PageMode.String == PageModeCursor || g.Cursor.Valid
+}
+
+func (g *GetCarsByParam) GetCursorPage() (CursorPage, error) {
+	return NewCursorPage(g.OrderBy.String, g.Cursor.String, CarSortColumns, g.Limit)
 }
 
 func (g *GetCarsByParam) GetQuery() []qm.QueryMod {
@@ -134,19 +171,19 @@ func (g *GetCarsByParam) GetQuery() []qm.QueryMod {
 	}
 
 	if g.DayRateGT.Valid {
-		res = append(res, qm.Where("day_rate >?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate >?", g.DayRateGT.Float64))
 	}
 
 	if g.DayRateGTE.Valid {
-		res = append(res, qm.Where("day_rate >=?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate >=?", g.DayRateGTE.Float64))
 	}
 
 	if g.DayRateLT.Valid {
-		res = append(res, qm.Where("day_rate <?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate <?", g.DayRateLT.Float64))
 	}
 
 	if g.DayRateLTE.Valid {
-		res = append(res, qm.Where("day_rate <=?", g.DayRate.Float64))
+		res = append(res, qm.Where("day_rate <=?", g.DayRateLTE.Float64))
 	}
 
 	if g.MonthRate.Valid {
@@ -173,13 +210,44 @@ func (g *GetCarsByParam) GetQuery() []qm.QueryMod {
 		res = append(res, qm.Where("image=?", g.Image.String))
 	}
 
-	if g.OrderBy.Valid {
-		order := strings.Split(g.OrderBy.String, ",")
-		for _, o := range order {
-			res = append(res, qm.OrderBy(o))
-		}
+	if g.OrderBy.Valid && !g.IsCursor() {
+		res = append(res, getSortQuery(g.OrderBy.String, CarSortColumns)...)
+	}
+
+	return res
+}
+
+type GetAvailableCarsByParam struct {
+	GetCarsByParam
+	PickupDate  null.Time `schema:"pickup_date" json:"pickup_date" swaggertype:"string"`
+	DropoffDate null.Time `schema:"dropoff_date" json:"dropoff_date" swaggertype:"string"`
+}
+
+func (g *GetAvailableCarsByParam) Validate() error {
+	if !g.PickupDate.Valid {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidPickupDate, nil, "invalid pickup date")
+	}
+
+	if !g.DropoffDate.Valid || g.DropoffDate.Time.Before(g.PickupDate.Time) {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidDropoffDate, nil, "invalid dropoff date")
+	}
+
+	return g.GetCarsByParam.Validate()
+}
+
+func (g *GetAvailableCarsByParam) FillGrpcClient() *grpcmodel.GetAvailableCarsRequest {
+	return &grpcmodel.GetAvailableCarsRequest{
+		PickupDate:  g.PickupDate.Time.Format(time.RFC3339),
+		DropoffDate: g.DropoffDate.Time.Format(time.RFC3339),
+		Param:       g.GetCarsByParam.FillGrpcClient(),
 	}
+}
 
+// GetQuery returns the car filters narrowed to cars without any non-deleted
+// order overlapping the pickup and dropoff dates.
+func (g *GetAvailableCarsByParam) GetQuery() []qm.QueryMod {
+	res := g.GetCarsByParam.GetQuery()
+	res = append(res, qm.Where(`NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."car_id"="cars"."id" AND "orders"."deleted_at" IS NULL AND "orders"."status"<>? AND "orders"."pickup_date"<=? AND "orders"."dropoff_date">=?)`, OrderStatusCancelled, g.DropoffDate.Time, g.PickupDate.Time))
 	return res
 }
 
@@ -188,7 +256,8 @@ type CreateCar struct {
 	DayRate   float64 `json:"day_rate" validate:"required,min=10000,max=1000000"`
 	MonthRate float64 `json:"month_rate" validate:"required,min=250000,max=30000000"`
 	Image     string  `json:"image" validate:"required,uri,min=5,max=256"`
-	CreatedBy int64   `json:"-"`
+
+	IdempotencyKey string `json:"-" validate:"max=255"`
 }
 
 func (v *CreateCar) Validate() error {
@@ -216,7 +285,8 @@ type UpdateCar struct {
 	DayRate   null.Float64 `json:"day_rate" swaggertype:"number" validate:"required,min=10000,max=1000000"`
 	MonthRate null.Float64 `json:"month_rate" swaggertype:"number" validate:"required,min=250000,max=30000000"`
 	Image     null.String  `json:"image" swaggertype:"string" validate:"required,uri,min=5,max=256"`
-	UpdatedBy int64        `json:"-"`
+
+	ExpectedVersion *int64 `json:"-"`
 }
 
 func (v *UpdateCar) FillGrpcClient() *grpcmodel.UpdateCarRequest {
@@ -252,6 +322,7 @@ type Car struct {
 	DayRate   float64 `json:"day_rate"`
 	MonthRate float64 `json:"month_rate"`
 	Image     string  `json:"image"`
+	Version   int64   `json:"version"`
 	BaseInformation
 }
 
@@ -280,6 +351,7 @@ func TransformPSQLSingleCar(car *psqlmodel.Car) Car {
 		DayRate:         car.DayRate,
 		MonthRate:       car.MonthRate,
 		Image:           car.Image,
+		Version:         int64(car.Version),
 		BaseInformation: creationInfo,
 	}
 }
@@ -311,6 +383,7 @@ func TransformPSQLCar(car *psqlmodel.CarSlice) []Car {
 			DayRate:         v.DayRate,
 			MonthRate:       v.MonthRate,
 			Image:           v.Image,
+			Version:         int64(v.Version),
 			BaseInformation: creationInfo,
 		})
 	}
@@ -325,6 +398,7 @@ func TransformSingleCarReply(v *psqlmodel.Car) *grpcmodel.SingleCarReply {
 		DayRate:   v.DayRate,
 		MonthRate: v.MonthRate,
 		Image:     v.Image,
+		Version:   int64(v.Version),
 		CreatedBy: int64(v.CreatedBy),
 		CreatedAt: v.CreatedAt.Format(time.RFC3339),
 		UpdatedBy: int64(v.UpdatedBy),
@@ -361,12 +435,35 @@ func TransformGetCarByParamRequestToCarParam(v *grpcmodel.GetCarByParamRequest)
 			MonthRateLTE: null.Float64FromPtr(v.MonthRateLte),
 			Image:        null.StringFromPtr(v.Image),
 		},
-		OrderBy: null.StringFromPtr(v.OrderBy),
-		Limit:   v.Limit,
-		Page:    v.Page,
+		OrderBy:  null.StringFromPtr(v.OrderBy),
+		Limit:    v.Limit,
+		Page:     v.Page,
+		PageMode: null.StringFromPtr(v.PageMode),
+		Cursor:   null.StringFromPtr(v.Cursor),
 	}
 }
 
+func TransformGetAvailableCarsRequestToCarParam(v *grpcmodel.GetAvailableCarsRequest) (GetAvailableCarsByParam, error) {
+	var res GetAvailableCarsByParam
+	if v.Param != nil {
+		res.GetCarsByParam = TransformGetCarByParamRequestToCarParam(v.Param)
+	}
+
+	pickupDate, err := time.Parse(time.RFC3339, v.PickupDate)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidPickupDate, err, "error parse pickup date")
+	}
+
+	dropoffDate, err := time.Parse(time.RFC3339, v.DropoffDate)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidDropoffDate, err, "error parse dropoff date")
+	}
+
+	res.PickupDate = null.TimeFrom(pickupDate)
+	res.DropoffDate = null.TimeFrom(dropoffDate)
+	return res, res.Validate()
+}
+
 func TransformCarToGetCarByParamReply(v *psqlmodel.CarSlice, p Pagination) *grpcmodel.GetCarByParamReply {
 	var (
 		data      []*grpcmodel.SingleCarReply
@@ -390,6 +487,7 @@ func TransformCarToGetCarByParamReply(v *psqlmodel.CarSlice, p Pagination) *grpc
 			DayRate:   val.DayRate,
 			MonthRate: val.MonthRate,
 			Image:     val.Image,
+			Version:   int64(val.Version),
 			CreatedBy: int64(val.CreatedBy),
 			CreatedAt: val.CreatedAt.Format(time.RFC3339),
 			UpdatedBy: int64(val.UpdatedBy),
@@ -407,6 +505,8 @@ func TransformCarToGetCarByParamReply(v *psqlmodel.CarSlice, p Pagination) *grpc
 			TotalPages:     p.TotalPages,
 			TotalElements:  p.TotalElements,
 			SortBy:         p.SortBy,
+			NextCursor:     p.NextCursor,
+			PrevCursor:     p.PrevCursor,
 		},
 	}
 }
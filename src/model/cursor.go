@@ -0,0 +1,229 @@
+package model
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// PageModeOffset pages by page number and counts the matching rows, it is
+	// the default. PageModeCursor pages by the cursor of the previous page
+	// and skips the count.
+	PageModeOffset = "offset"
+	PageModeCursor = "cursor"
+)
+
+// Cursor is the position a cursor page starts after, it holds the sort values
+// of the row it was taken from and is handed to clients as an opaque string.
+type Cursor struct {
+	OrderBy string        `json:"o"`
+	Values  []interface{} `json:"v"`
+	Prev    bool          `json:"p,omitempty"`
+}
+
+func EncodeCursor(c Cursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	var res Cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return res, err
+	}
+
+	err = decodeJSON(data, &res)
+	return res, err
+}
+
+// decodeJSON decodes data into v keeping numbers as json.Number, so they are
+// passed to the query as they were read.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// CursorPage is a page of rows sorted by Sorts that starts after Cursor, or
+// at the first row when Cursor is nil.
+type CursorPage struct {
+	Sorts   []Sort
+	OrderBy string
+	Cursor  *Cursor
+	Limit   int64
+}
+
+// validatePageMode returns an error when mode is neither of the page modes.
+func validatePageMode(mode null.String) error {
+	if !mode.Valid || mode.String == PageModeOffset || mode.String == PageModeCursor {
+		return nil
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCursor, nil, "invalid page mode "+mode.String)
+}
+
+// NewCursorPage returns the page of limit rows sorted by orderBy after
+// cursor, an empty cursor being the first page. The sort always ends with
+// the id so every row has a distinct position, and cursor has to be taken
+// from a page of the same sort.
+func NewCursorPage(orderBy, cursor string, columns map[string]bool, limit int64) (CursorPage, error) {
+	res := CursorPage{OrderBy: orderBy, Limit: limit}
+	sorts, err := ParseSort(orderBy, columns)
+	if err != nil {
+		return res, err
+	}
+
+	hasID := false
+	for _, sort := range sorts {
+		hasID = hasID || sort.Column == "id"
+	}
+
+	if !hasID {
+		sorts = append(sorts, Sort{Column: "id"})
+	}
+	res.Sorts = sorts
+
+	if cursor == "" {
+		return res, nil
+	}
+
+	c, err := DecodeCursor(cursor)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCursor, err, "error decode cursor")
+	}
+
+	if c.OrderBy != orderBy || len(c.Values) != len(sorts) {
+		return res, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCursor, nil, "cursor is not of sort "+orderBy)
+	}
+
+	for i, v := range c.Values {
+		switch val := v.(type) {
+		case json.Number:
+			c.Values[i] = val.String()
+		case string:
+		default:
+			return res, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCursor, nil, "invalid cursor value")
+		}
+	}
+
+	res.Cursor = &c
+	return res, nil
+}
+
+// IsPrev reports whether p goes back from its cursor.
+func (p CursorPage) IsPrev() bool {
+	return p.Cursor != nil && p.Cursor.Prev
+}
+
+// GetQuery returns the query of the rows of p, sorted backwards when p goes
+// back. It reads a row past the limit to tell whether there is a further
+// page.
+func (p CursorPage) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	prev := p.IsPrev()
+	if p.Cursor != nil {
+		var (
+			clauses []string
+			args    []interface{}
+		)
+		for i, sort := range p.Sorts {
+			var clause []string
+			for _, eq := range p.Sorts[:i] {
+				clause = append(clause, eq.Column+"=?")
+			}
+
+			op := ">?"
+			if sort.Desc != prev {
+				op = "<?"
+			}
+			clause = append(clause, sort.Column+op)
+			clauses = append(clauses, "("+strings.Join(clause, " AND ")+")")
+			args = append(args, p.Cursor.Values[:i+1]...)
+		}
+		res = append(res, qm.Where(strings.Join(clauses, " OR "), args...))
+	}
+
+	for _, sort := range p.Sorts {
+		sort.Desc = sort.Desc != prev
+		res = append(res, qm.OrderBy(sort.String()))
+	}
+
+	res = append(res, qm.Limit(int(p.Limit+1)))
+	return res
+}
+
+// GetPagination returns the pagination of the rows read by the query of p
+// once put back in order, first and last being its first and last row or
+// nil when there is none. There is a next page when hasMore or when p went
+// back, and a previous page when p went forward from a cursor or hasMore.
+func (p CursorPage) GetPagination(first, last interface{}, count int, hasMore bool) (Pagination, error) {
+	res := Pagination{
+		CurrentElements: int64(count),
+		SortBy:          p.OrderBy,
+	}
+	if first == nil || last == nil {
+		return res, nil
+	}
+
+	prev := p.IsPrev()
+	if hasMore || prev {
+		cursor, err := p.getCursor(last, false)
+		if err != nil {
+			return res, err
+		}
+		res.NextCursor = cursor
+	}
+
+	if hasMore && prev || p.Cursor != nil && !prev {
+		cursor, err := p.getCursor(first, true)
+		if err != nil {
+			return res, err
+		}
+		res.PrevCursor = cursor
+	}
+
+	return res, nil
+}
+
+// getCursor returns the encoded cursor of the sort values of row, which are
+// read through its json fields named after the columns.
+func (p CursorPage) getCursor(row interface{}, prev bool) (string, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal cursor row")
+	}
+
+	var fields map[string]interface{}
+	err = decodeJSON(data, &fields)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal cursor row")
+	}
+
+	c := Cursor{OrderBy: p.OrderBy, Prev: prev}
+	for _, sort := range p.Sorts {
+		v, ok := fields[sort.Column]
+		if !ok {
+			return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, nil, "cursor row has no "+sort.Column)
+		}
+		c.Values = append(c.Values, v)
+	}
+
+	cursor, err := EncodeCursor(c)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error encode cursor")
+	}
+
+	return cursor, nil
+}
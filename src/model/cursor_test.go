@@ -0,0 +1,118 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOrdersByParamCursorValidate(t *testing.T) {
+	Convey("test validate cursor page", t, func() {
+		cursor, _ := model.EncodeCursor(model.Cursor{OrderBy: "-pickup_date", Values: []interface{}{"2024-02-02T00:00:00Z", 3}})
+		nested, _ := model.EncodeCursor(model.Cursor{OrderBy: "-pickup_date", Values: []interface{}{"2024-02-02T00:00:00Z", []int{3}}})
+		tests := []struct {
+			testType string
+			testDesc string
+			param    model.GetOrdersByParam
+			code     int
+		}{
+			{testType: "P", testDesc: "first page", param: model.GetOrdersByParam{PageMode: null.StringFrom(model.PageModeCursor), OrderBy: null.StringFrom("-pickup_date")}},
+			{testType: "P", testDesc: "cursor implies cursor mode", param: model.GetOrdersByParam{Cursor: null.StringFrom(cursor), OrderBy: null.StringFrom("-pickup_date")}},
+			{testType: "N", testDesc: "unknown page mode", param: model.GetOrdersByParam{PageMode: null.StringFrom("seek")}, code: svcerr.CodeInvalidCursor},
+			{testType: "N", testDesc: "cursor not base64", param: model.GetOrdersByParam{Cursor: null.StringFrom("not a cursor!")}, code: svcerr.CodeInvalidCursor},
+			{testType: "N", testDesc: "cursor of another sort", param: model.GetOrdersByParam{Cursor: null.StringFrom(cursor), OrderBy: null.StringFrom("pickup_date")}, code: svcerr.CodeInvalidCursor},
+			{testType: "N", testDesc: "cursor with nested value", param: model.GetOrdersByParam{Cursor: null.StringFrom(nested), OrderBy: null.StringFrom("-pickup_date")}, code: svcerr.CodeInvalidCursor},
+			{testType: "N", testDesc: "distance sort in cursor mode", param: model.GetOrdersByParam{
+				GetOrderByParam: model.GetOrderByParam{PickupNearLat: null.Float64From(-6.2), PickupNearLong: null.Float64From(106.8), PickupRadiusKM: null.Float64From(5)},
+				PageMode:        null.StringFrom(model.PageModeCursor),
+				OrderBy:         null.StringFrom("pickup_distance"),
+			}, code: svcerr.CodeInvalidSort},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := test.param.Validate()
+				if test.testType == "N" {
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.code)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestCursorPage(t *testing.T) {
+	Convey("test walk cursor pages", t, func() {
+		param := model.GetOrdersByParam{
+			GetOrderByParam: model.GetOrderByParam{CarID: null.Int64From(2)},
+			OrderBy:         null.StringFrom("-pickup_date"),
+			Limit:           2,
+			PageMode:        null.StringFrom(model.PageModeCursor),
+		}
+		first := &psqlmodel.Order{ID: 5, PickupDate: time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)}
+		last := &psqlmodel.Order{ID: 4, PickupDate: time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)}
+
+		Convey("0 - [P] : test first page has no cursor and is not sorted twice", func() {
+			page, err := param.GetCursorPage()
+			So(err, ShouldBeNil)
+
+			query, _ := queries.BuildQuery(psqlmodel.Orders(append(param.GetQuery(), page.GetQuery()...)...).Query)
+			So(query, ShouldEqual, `SELECT "orders".* FROM "orders" WHERE (car_id=$1) AND ("orders"."deleted_at" is null) ORDER BY pickup_date DESC, id ASC LIMIT 3;`)
+
+			pg, err := page.GetPagination(first, last, 2, true)
+			So(err, ShouldBeNil)
+			So(pg.NextCursor, ShouldNotBeEmpty)
+			So(pg.PrevCursor, ShouldBeEmpty)
+			So(pg.TotalPages, ShouldEqual, 0)
+
+			Convey("1 - [P] : test next page starts after the last row", func() {
+				param.Cursor = null.StringFrom(pg.NextCursor)
+				page, err := param.GetCursorPage()
+				So(err, ShouldBeNil)
+
+				query, args := queries.BuildQuery(psqlmodel.Orders(append(param.GetQuery(), page.GetQuery()...)...).Query)
+				So(query, ShouldEqual, `SELECT "orders".* FROM "orders" WHERE (car_id=$1) AND ((pickup_date<$2) OR (pickup_date=$3 AND id>$4)) AND ("orders"."deleted_at" is null) ORDER BY pickup_date DESC, id ASC LIMIT 3;`)
+				So(args, ShouldResemble, []interface{}{int64(2), "2024-02-02T00:00:00Z", "2024-02-02T00:00:00Z", "4"})
+
+				pg, err := page.GetPagination(first, last, 2, false)
+				So(err, ShouldBeNil)
+				So(pg.NextCursor, ShouldBeEmpty)
+				So(pg.PrevCursor, ShouldNotBeEmpty)
+
+				Convey("2 - [P] : test previous page is read backwards from the first row", func() {
+					param.Cursor = null.StringFrom(pg.PrevCursor)
+					page, err := param.GetCursorPage()
+					So(err, ShouldBeNil)
+					So(page.IsPrev(), ShouldBeTrue)
+
+					query, args := queries.BuildQuery(psqlmodel.Orders(append(param.GetQuery(), page.GetQuery()...)...).Query)
+					So(query, ShouldEqual, `SELECT "orders".* FROM "orders" WHERE (car_id=$1) AND ((pickup_date>$2) OR (pickup_date=$3 AND id<$4)) AND ("orders"."deleted_at" is null) ORDER BY pickup_date ASC, id DESC LIMIT 3;`)
+					So(args, ShouldResemble, []interface{}{int64(2), "2024-02-03T00:00:00Z", "2024-02-03T00:00:00Z", "5"})
+
+					pg, err := page.GetPagination(first, last, 2, false)
+					So(err, ShouldBeNil)
+					So(pg.NextCursor, ShouldNotBeEmpty)
+					So(pg.PrevCursor, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("3 - [P] : test empty page has no cursor", func() {
+			page, err := param.GetCursorPage()
+			So(err, ShouldBeNil)
+
+			pg, err := page.GetPagination(nil, nil, 0, false)
+			So(err, ShouldBeNil)
+			So(pg, ShouldResemble, model.Pagination{SortBy: "-pickup_date"})
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/common"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// OrderIncludeCar embeds the car of every order.
+const OrderIncludeCar = "car"
+
+// GetIncludes returns the relations to embed in every order of g.
+func (g *GetOrdersByParam) GetIncludes() []string {
+	return splitList(g.Include)
+}
+
+func (g *GetOrdersByParam) HasInclude(include string) bool {
+	return common.FindStrInSlice(include, g.GetIncludes())
+}
+
+// GetFields returns the fields every order of g is limited to, such as id or
+// car.car_name, every field is kept when it is empty.
+func (g *GetOrdersByParam) GetFields() []string {
+	return splitList(g.Fields)
+}
+
+// validateFields returns an error when g includes anything but the car or
+// asks for a field an order does not have.
+func (g *GetOrdersByParam) validateFields() error {
+	var template Order
+	for _, include := range g.GetIncludes() {
+		if include != OrderIncludeCar {
+			return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidFields, nil, "invalid include "+include)
+		}
+		template.Car = &Car{}
+	}
+
+	return validateFields(template, g.GetFields())
+}
+
+// splitList returns the trimmed items of the comma separated v.
+func splitList(v null.String) []string {
+	var res []string
+	if !v.Valid {
+		return res
+	}
+
+	for _, item := range strings.Split(v.String, ",") {
+		res = append(res, strings.TrimSpace(item))
+	}
+
+	return res
+}
+
+// fieldTree holds the fields to keep by name, the fields below a name are
+// all kept when its tree is nil.
+type fieldTree map[string]fieldTree
+
+func getFieldTree(paths []string) fieldTree {
+	tree := fieldTree{}
+	for _, path := range paths {
+		node := tree
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			sub, ok := node[name]
+			if ok && sub == nil {
+				break
+			}
+
+			if i == len(names)-1 {
+				node[name] = nil
+				break
+			}
+
+			if !ok {
+				sub = fieldTree{}
+				node[name] = sub
+			}
+			node = sub
+		}
+	}
+
+	return tree
+}
+
+// validateFields returns an error unless every path names a json field of
+// template.
+func validateFields(template interface{}, paths []string) error {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal fields template")
+	}
+
+	var value interface{}
+	err = decodeJSON(data, &value)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal fields template")
+	}
+
+	for _, path := range paths {
+		node := value
+		for _, name := range strings.Split(path, ".") {
+			fields, ok := node.(map[string]interface{})
+			if ok {
+				node, ok = fields[name]
+			}
+
+			if !ok {
+				return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidFields, nil, "invalid field "+path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FilterFields returns the json value of v limited to paths, a path such as
+// car.car_name keeps the named field of an object only. A list is limited
+// item by item and v is returned as is when there is no path.
+func FilterFields(v interface{}, paths []string) (interface{}, error) {
+	if len(paths) == 0 {
+		return v, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	err = decodeJSON(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterValue(value, getFieldTree(paths)), nil
+}
+
+func filterValue(value interface{}, tree fieldTree) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		for i := range v {
+			v[i] = filterValue(v[i], tree)
+		}
+		return v
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(tree))
+		for name, sub := range tree {
+			field, ok := v[name]
+			if !ok {
+				continue
+			}
+
+			if sub == nil {
+				res[name] = field
+				continue
+			}
+			res[name] = filterValue(field, sub)
+		}
+		return res
+	}
+
+	return value
+}
+
+// ValidateFieldMask returns an error unless every path of mask names a field
+// of m, a nil mask keeps every field.
+func ValidateFieldMask(m protoreflect.ProtoMessage, mask *fieldmaskpb.FieldMask) error {
+	if mask == nil || mask.IsValid(m) {
+		return nil
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidFields, nil, "invalid field mask "+strings.Join(mask.GetPaths(), ","))
+}
+
+// ApplyFieldMask clears every field of m outside of the paths of mask, it is
+// the grpc counterpart of FilterFields. A mask without path keeps every
+// field.
+func ApplyFieldMask(m protoreflect.ProtoMessage, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+
+	applyFieldTree(m.ProtoReflect(), getFieldTree(mask.GetPaths()))
+}
+
+func applyFieldTree(m protoreflect.Message, tree fieldTree) {
+	var outside []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree[string(fd.Name())]
+		switch {
+		case !ok:
+			outside = append(outside, fd)
+		case sub != nil && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			applyFieldTree(v.Message(), sub)
+		}
+		return true
+	})
+
+	for _, fd := range outside {
+		m.Clear(fd)
+	}
+}
@@ -0,0 +1,114 @@
+package model_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOrdersByParamFieldsValidate(t *testing.T) {
+	Convey("test validate includes and fields", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			include  null.String
+			fields   null.String
+		}{
+			{testType: "P", testDesc: "no include and field"},
+			{testType: "P", testDesc: "include car", include: null.StringFrom("car")},
+			{testType: "P", testDesc: "order and nested fields", fields: null.StringFrom("id, status,price.subtotal,created_at")},
+			{testType: "P", testDesc: "car field with car included", include: null.StringFrom("car"), fields: null.StringFrom("id,car.car_name,car.image")},
+			{testType: "N", testDesc: "unknown include", include: null.StringFrom("customer")},
+			{testType: "N", testDesc: "unknown field", fields: null.StringFrom("id,secret")},
+			{testType: "N", testDesc: "field below a value", fields: null.StringFrom("status.name")},
+			{testType: "N", testDesc: "empty field", fields: null.StringFrom("id,")},
+			{testType: "N", testDesc: "car field without car included", fields: null.StringFrom("car.car_name")},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				param := model.GetOrdersByParam{Include: test.include, Fields: test.fields}
+				err := param.Validate()
+				if test.testType == "N" {
+					So(errormsg.GetErrorCode(err), ShouldEqual, svcerr.CodeInvalidFields)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestOrdersResponseFields(t *testing.T) {
+	Convey("test orders response limited to fields", t, func() {
+		response := model.OrdersResponse{
+			Data: []model.Order{
+				{ID: 1, Status: model.OrderStatusPending, Car: &model.Car{ID: 2, CarName: "sedan", Image: "sedan.png"}},
+			},
+			Pagination: model.Pagination{CurrentPage: 1},
+		}
+
+		Convey("0 - [P] : test every field kept without fields", func() {
+			data, err := json.Marshal(response)
+			So(err, ShouldBeNil)
+
+			var res struct {
+				Data []map[string]interface{} `json:"data"`
+			}
+			So(json.Unmarshal(data, &res), ShouldBeNil)
+			So(res.Data[0], ShouldContainKey, "pickup_date")
+			So(res.Data[0]["car"], ShouldContainKey, "image")
+		})
+
+		Convey("1 - [P] : test data limited to fields", func() {
+			response.Fields = []string{"id", "car.car_name"}
+			data, err := json.Marshal(response)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, `"data":[{"car":{"car_name":"sedan"},"id":1}]`)
+			So(string(data), ShouldContainSubstring, `"pagination":{"current_page":1`)
+		})
+	})
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	Convey("test apply field mask to order reply", t, func() {
+		order := &psqlmodel.Order{ID: 1, CarID: 2, Status: model.OrderStatusPending, Subtotal: 300}
+		order.R = order.R.NewStruct()
+		order.R.Car = &psqlmodel.Car{ID: 2, CarName: "sedan", Image: "sedan.png"}
+		reply := model.TransformSingleOrderReply(order)
+		So(reply.Car.CarName, ShouldEqual, "sedan")
+
+		Convey("0 - [P] : test mask keeps its fields only", func() {
+			mask := &fieldmaskpb.FieldMask{Paths: []string{"id", "price.subtotal", "car.car_name"}}
+			So(model.ValidateFieldMask(&grpcmodel.SingleOrderReply{}, mask), ShouldBeNil)
+
+			model.ApplyFieldMask(reply, mask)
+			So(proto.Equal(reply, &grpcmodel.SingleOrderReply{
+				Id:    1,
+				Price: &grpcmodel.OrderPrice{Subtotal: 300},
+				Car:   &grpcmodel.SingleCarReply{CarName: "sedan"},
+			}), ShouldBeTrue)
+		})
+
+		Convey("1 - [P] : test empty mask keeps every field", func() {
+			model.ApplyFieldMask(reply, &fieldmaskpb.FieldMask{})
+			So(reply.Status, ShouldEqual, model.OrderStatusPending)
+			So(reply.Car.Image, ShouldEqual, "sedan.png")
+		})
+
+		Convey("2 - [N] : test unknown path is invalid", func() {
+			mask := &fieldmaskpb.FieldMask{Paths: []string{"car.secret"}}
+			So(errormsg.GetErrorCode(model.ValidateFieldMask(&grpcmodel.SingleOrderReply{}, mask)), ShouldEqual, svcerr.CodeInvalidFields)
+		})
+	})
+}
@@ -115,6 +115,7 @@ func TransformSingleCarReplyToCar(ctx context.Context, v *grpcmodel.SingleCarRep
 		DayRate:   v.DayRate,
 		MonthRate: v.MonthRate,
 		Image:     v.Image,
+		Version:   v.Version,
 		BaseInformation: BaseInformation{
 			CreatedBy: v.CreatedBy,
 			CreatedAt: createdAt,
@@ -157,6 +158,7 @@ func TransformCarByParamReplyToCar(ctx context.Context, v *grpcmodel.GetCarByPar
 			DayRate:   val.DayRate,
 			MonthRate: val.MonthRate,
 			Image:     val.Image,
+			Version:   val.Version,
 			BaseInformation: BaseInformation{
 				CreatedBy: val.CreatedBy,
 				CreatedAt: createdAt,
@@ -175,6 +177,8 @@ func TransformCarByParamReplyToCar(ctx context.Context, v *grpcmodel.GetCarByPar
 			TotalPages:      v.Pagination.TotalPages,
 			TotalElements:   v.Pagination.TotalElements,
 			SortBy:          v.Pagination.SortBy,
+			NextCursor:      v.Pagination.NextCursor,
+			PrevCursor:      v.Pagination.PrevCursor,
 		}
 	}
 
@@ -210,6 +214,24 @@ func TransformSingleOrderReplyToOrder(ctx context.Context, v *grpcmodel.SingleOr
 		deletedAt = null.TimeFrom(tParse)
 	}
 
+	originalDropoffDate := null.Time{}
+	if v.OriginalDropoffDate != nil {
+		tParse, err := time.Parse(time.RFC3339, *v.OriginalDropoffDate)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		originalDropoffDate = null.TimeFrom(tParse)
+	}
+
+	overdueAt := null.Time{}
+	if v.OverdueAt != nil {
+		tParse, err := time.Parse(time.RFC3339, *v.OverdueAt)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		overdueAt = null.TimeFrom(tParse)
+	}
+
 	pickupDate, err = time.Parse(time.RFC3339, v.PickupDate)
 	if err != nil {
 		log.Error(ctx, err)
@@ -225,18 +247,32 @@ func TransformSingleOrderReplyToOrder(ctx context.Context, v *grpcmodel.SingleOr
 		log.Error(ctx, err)
 	}
 
+	var car *Car
+	if v.Car != nil {
+		singleCar := TransformSingleCarReplyToCar(ctx, v.Car, log)
+		car = &singleCar
+	}
+
 	return Order{
-		ID:              v.Id,
-		CarID:           v.CarId,
-		OrderDate:       orderDate,
-		PickupDate:      pickupDate,
-		DropoffDate:     dropoffDate,
-		PickupLocation:  v.PickupLocation,
-		PickupLat:       v.PickupLat,
-		PickupLong:      v.PickupLong,
-		DropoffLocation: v.DropoffLocation,
-		DropoffLat:      v.DropoffLat,
-		DropoffLong:     v.DropoffLong,
+		ID:                  v.Id,
+		CarID:               v.CarId,
+		OrderDate:           orderDate,
+		PickupDate:          pickupDate,
+		DropoffDate:         dropoffDate,
+		OriginalDropoffDate: originalDropoffDate,
+		PickupLocation:      v.PickupLocation,
+		PickupLat:           v.PickupLat,
+		PickupLong:          v.PickupLong,
+		DropoffLocation:     v.DropoffLocation,
+		DropoffLat:          v.DropoffLat,
+		DropoffLong:         v.DropoffLong,
+		Price:               TransformOrderPriceReplyToOrderPrice(v.Price),
+		Status:              v.Status,
+		OverdueAt:           overdueAt,
+		LateFee:             v.LateFee,
+		Version:             v.Version,
+		Cancellation:        TransformOrderCancellationReplyToOrderCancellation(ctx, v, log),
+		Car:                 car,
 		BaseInformation: BaseInformation{
 			CreatedBy: v.CreatedBy,
 			CreatedAt: createdAt,
@@ -250,53 +286,12 @@ func TransformSingleOrderReplyToOrder(ctx context.Context, v *grpcmodel.SingleOr
 
 func TransformOrderByParamReplyToOrder(ctx context.Context, v *grpcmodel.GetOrderByParamReply, log logger.Logger) ([]Order, Pagination) {
 	var (
-		orders      []Order
-		pickupDate  time.Time
-		dropoffDate time.Time
-		orderDate   time.Time
-		pagination  Pagination
+		orders     []Order
+		pagination Pagination
 	)
 
 	for _, val := range v.Data {
-		createdAt, err := time.Parse(time.RFC3339, val.CreatedAt)
-		if err != nil {
-			log.Error(ctx, err, "error parsing time")
-		}
-
-		updatedAt, err := time.Parse(time.RFC3339, val.UpdatedAt)
-		if err != nil {
-			log.Error(ctx, err, "error parsing time")
-		}
-
-		deletedAt := null.Time{}
-		if val.DeletedAt != nil {
-			tParse, err := time.Parse(time.RFC3339, *val.DeletedAt)
-			if err != nil {
-				log.Error(ctx, err, "error parsing time")
-			}
-			deletedAt = null.TimeFrom(tParse)
-		}
-		orders = append(orders, Order{
-			ID:              val.Id,
-			CarID:           val.CarId,
-			OrderDate:       orderDate,
-			PickupDate:      pickupDate,
-			DropoffDate:     dropoffDate,
-			PickupLocation:  val.PickupLocation,
-			PickupLat:       val.PickupLat,
-			PickupLong:      val.PickupLong,
-			DropoffLocation: val.DropoffLocation,
-			DropoffLat:      val.DropoffLat,
-			DropoffLong:     val.DropoffLong,
-			BaseInformation: BaseInformation{
-				CreatedBy: val.CreatedBy,
-				CreatedAt: createdAt,
-				UpdatedBy: val.UpdatedBy,
-				UpdatedAt: updatedAt,
-				DeletedBy: null.Int64FromPtr(val.DeletedBy),
-				DeletedAt: deletedAt,
-			},
-		})
+		orders = append(orders, TransformSingleOrderReplyToOrder(ctx, val, log))
 	}
 
 	if v.Pagination != nil {
@@ -306,6 +301,8 @@ func TransformOrderByParamReplyToOrder(ctx context.Context, v *grpcmodel.GetOrde
 			TotalPages:      v.Pagination.TotalPages,
 			TotalElements:   v.Pagination.TotalElements,
 			SortBy:          v.Pagination.SortBy,
+			NextCursor:      v.Pagination.NextCursor,
+			PrevCursor:      v.Pagination.PrevCursor,
 		}
 	}
 
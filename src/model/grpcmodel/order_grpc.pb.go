@@ -19,16 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Order_CreateOrder_FullMethodName     = "/order.Order/CreateOrder"
-	Order_UpdateOrder_FullMethodName     = "/order.Order/UpdateOrder"
-	Order_DeleteOrder_FullMethodName     = "/order.Order/DeleteOrder"
-	Order_GetOrderByID_FullMethodName    = "/order.Order/GetOrderByID"
-	Order_GetOrderByParam_FullMethodName = "/order.Order/GetOrderByParam"
-	Order_CreateCar_FullMethodName       = "/order.Order/CreateCar"
-	Order_UpdateCar_FullMethodName       = "/order.Order/UpdateCar"
-	Order_DeleteCar_FullMethodName       = "/order.Order/DeleteCar"
-	Order_GetCarByID_FullMethodName      = "/order.Order/GetCarByID"
-	Order_GetCarByParam_FullMethodName   = "/order.Order/GetCarByParam"
+	Order_CreateOrder_FullMethodName           = "/order.Order/CreateOrder"
+	Order_UpdateOrder_FullMethodName           = "/order.Order/UpdateOrder"
+	Order_DeleteOrder_FullMethodName           = "/order.Order/DeleteOrder"
+	Order_GetOrderByID_FullMethodName          = "/order.Order/GetOrderByID"
+	Order_GetOrderByParam_FullMethodName       = "/order.Order/GetOrderByParam"
+	Order_QuoteOrder_FullMethodName            = "/order.Order/QuoteOrder"
+	Order_ConfirmOrder_FullMethodName          = "/order.Order/ConfirmOrder"
+	Order_PickupOrder_FullMethodName           = "/order.Order/PickupOrder"
+	Order_ReturnOrder_FullMethodName           = "/order.Order/ReturnOrder"
+	Order_CompleteOrder_FullMethodName         = "/order.Order/CompleteOrder"
+	Order_CancelOrder_FullMethodName           = "/order.Order/CancelOrder"
+	Order_ExtendOrder_FullMethodName           = "/order.Order/ExtendOrder"
+	Order_GetOrderStatusHistory_FullMethodName = "/order.Order/GetOrderStatusHistory"
+	Order_WatchOrders_FullMethodName           = "/order.Order/WatchOrders"
+	Order_CreateCar_FullMethodName             = "/order.Order/CreateCar"
+	Order_UpdateCar_FullMethodName             = "/order.Order/UpdateCar"
+	Order_DeleteCar_FullMethodName             = "/order.Order/DeleteCar"
+	Order_GetCarByID_FullMethodName            = "/order.Order/GetCarByID"
+	Order_GetCarByParam_FullMethodName         = "/order.Order/GetCarByParam"
+	Order_GetAvailableCars_FullMethodName      = "/order.Order/GetAvailableCars"
+	Order_CreateWebhook_FullMethodName         = "/order.Order/CreateWebhook"
+	Order_GetWebhooks_FullMethodName           = "/order.Order/GetWebhooks"
+	Order_DeleteWebhook_FullMethodName         = "/order.Order/DeleteWebhook"
+	Order_GetWebhookDeliveries_FullMethodName  = "/order.Order/GetWebhookDeliveries"
+	Order_ReplayWebhookDelivery_FullMethodName = "/order.Order/ReplayWebhookDelivery"
+	Order_GetAuditLogs_FullMethodName          = "/order.Order/GetAuditLogs"
 )
 
 // OrderClient is the client API for Order service.
@@ -40,11 +56,27 @@ type OrderClient interface {
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderReply, error)
 	GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
 	GetOrderByParam(ctx context.Context, in *GetOrderByParamRequest, opts ...grpc.CallOption) (*GetOrderByParamReply, error)
+	QuoteOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*QuoteOrderReply, error)
+	ConfirmOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
+	PickupOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
+	ReturnOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
+	CompleteOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
+	CancelOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error)
+	ExtendOrder(ctx context.Context, in *ExtendOrderRequest, opts ...grpc.CallOption) (*ExtendOrderReply, error)
+	GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, opts ...grpc.CallOption) (*GetOrderStatusHistoryReply, error)
+	WatchOrders(ctx context.Context, in *GetOrderByParamRequest, opts ...grpc.CallOption) (Order_WatchOrdersClient, error)
 	CreateCar(ctx context.Context, in *CreateCarRequest, opts ...grpc.CallOption) (*SingleCarReply, error)
 	UpdateCar(ctx context.Context, in *UpdateCarRequest, opts ...grpc.CallOption) (*SingleCarReply, error)
 	DeleteCar(ctx context.Context, in *DeleteCarRequest, opts ...grpc.CallOption) (*DeleteCarReply, error)
 	GetCarByID(ctx context.Context, in *GetCarByIDRequest, opts ...grpc.CallOption) (*SingleCarReply, error)
 	GetCarByParam(ctx context.Context, in *GetCarByParamRequest, opts ...grpc.CallOption) (*GetCarByParamReply, error)
+	GetAvailableCars(ctx context.Context, in *GetAvailableCarsRequest, opts ...grpc.CallOption) (*GetCarByParamReply, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*SingleWebhookReply, error)
+	GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksReply, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error)
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesReply, error)
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveryReply, error)
+	GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*GetAuditLogsReply, error)
 }
 
 type orderClient struct {
@@ -100,6 +132,110 @@ func (c *orderClient) GetOrderByParam(ctx context.Context, in *GetOrderByParamRe
 	return out, nil
 }
 
+func (c *orderClient) QuoteOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*QuoteOrderReply, error) {
+	out := new(QuoteOrderReply)
+	err := c.cc.Invoke(ctx, Order_QuoteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ConfirmOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error) {
+	out := new(SingleOrderReply)
+	err := c.cc.Invoke(ctx, Order_ConfirmOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) PickupOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error) {
+	out := new(SingleOrderReply)
+	err := c.cc.Invoke(ctx, Order_PickupOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ReturnOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error) {
+	out := new(SingleOrderReply)
+	err := c.cc.Invoke(ctx, Order_ReturnOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CompleteOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error) {
+	out := new(SingleOrderReply)
+	err := c.cc.Invoke(ctx, Order_CompleteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CancelOrder(ctx context.Context, in *OrderTransitionRequest, opts ...grpc.CallOption) (*SingleOrderReply, error) {
+	out := new(SingleOrderReply)
+	err := c.cc.Invoke(ctx, Order_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ExtendOrder(ctx context.Context, in *ExtendOrderRequest, opts ...grpc.CallOption) (*ExtendOrderReply, error) {
+	out := new(ExtendOrderReply)
+	err := c.cc.Invoke(ctx, Order_ExtendOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, opts ...grpc.CallOption) (*GetOrderStatusHistoryReply, error) {
+	out := new(GetOrderStatusHistoryReply)
+	err := c.cc.Invoke(ctx, Order_GetOrderStatusHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) WatchOrders(ctx context.Context, in *GetOrderByParamRequest, opts ...grpc.CallOption) (Order_WatchOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[0], Order_WatchOrders_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderWatchOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_WatchOrdersClient interface {
+	Recv() (*OrderEventReply, error)
+	grpc.ClientStream
+}
+
+type orderWatchOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderWatchOrdersClient) Recv() (*OrderEventReply, error) {
+	m := new(OrderEventReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *orderClient) CreateCar(ctx context.Context, in *CreateCarRequest, opts ...grpc.CallOption) (*SingleCarReply, error) {
 	out := new(SingleCarReply)
 	err := c.cc.Invoke(ctx, Order_CreateCar_FullMethodName, in, out, opts...)
@@ -145,6 +281,69 @@ func (c *orderClient) GetCarByParam(ctx context.Context, in *GetCarByParamReques
 	return out, nil
 }
 
+func (c *orderClient) GetAvailableCars(ctx context.Context, in *GetAvailableCarsRequest, opts ...grpc.CallOption) (*GetCarByParamReply, error) {
+	out := new(GetCarByParamReply)
+	err := c.cc.Invoke(ctx, Order_GetAvailableCars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*SingleWebhookReply, error) {
+	out := new(SingleWebhookReply)
+	err := c.cc.Invoke(ctx, Order_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksReply, error) {
+	out := new(GetWebhooksReply)
+	err := c.cc.Invoke(ctx, Order_GetWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error) {
+	out := new(DeleteWebhookReply)
+	err := c.cc.Invoke(ctx, Order_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesReply, error) {
+	out := new(GetWebhookDeliveriesReply)
+	err := c.cc.Invoke(ctx, Order_GetWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveryReply, error) {
+	out := new(WebhookDeliveryReply)
+	err := c.cc.Invoke(ctx, Order_ReplayWebhookDelivery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*GetAuditLogsReply, error) {
+	out := new(GetAuditLogsReply)
+	err := c.cc.Invoke(ctx, Order_GetAuditLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility
@@ -154,11 +353,27 @@ type OrderServer interface {
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderReply, error)
 	GetOrderByID(context.Context, *GetOrderByIDRequest) (*SingleOrderReply, error)
 	GetOrderByParam(context.Context, *GetOrderByParamRequest) (*GetOrderByParamReply, error)
+	QuoteOrder(context.Context, *CreateOrderRequest) (*QuoteOrderReply, error)
+	ConfirmOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error)
+	PickupOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error)
+	ReturnOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error)
+	CompleteOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error)
+	CancelOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error)
+	ExtendOrder(context.Context, *ExtendOrderRequest) (*ExtendOrderReply, error)
+	GetOrderStatusHistory(context.Context, *GetOrderStatusHistoryRequest) (*GetOrderStatusHistoryReply, error)
+	WatchOrders(*GetOrderByParamRequest, Order_WatchOrdersServer) error
 	CreateCar(context.Context, *CreateCarRequest) (*SingleCarReply, error)
 	UpdateCar(context.Context, *UpdateCarRequest) (*SingleCarReply, error)
 	DeleteCar(context.Context, *DeleteCarRequest) (*DeleteCarReply, error)
 	GetCarByID(context.Context, *GetCarByIDRequest) (*SingleCarReply, error)
 	GetCarByParam(context.Context, *GetCarByParamRequest) (*GetCarByParamReply, error)
+	GetAvailableCars(context.Context, *GetAvailableCarsRequest) (*GetCarByParamReply, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*SingleWebhookReply, error)
+	GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesReply, error)
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*WebhookDeliveryReply, error)
+	GetAuditLogs(context.Context, *GetAuditLogsRequest) (*GetAuditLogsReply, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -181,6 +396,33 @@ func (UnimplementedOrderServer) GetOrderByID(context.Context, *GetOrderByIDReque
 func (UnimplementedOrderServer) GetOrderByParam(context.Context, *GetOrderByParamRequest) (*GetOrderByParamReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByParam not implemented")
 }
+func (UnimplementedOrderServer) QuoteOrder(context.Context, *CreateOrderRequest) (*QuoteOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
+func (UnimplementedOrderServer) ConfirmOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
+func (UnimplementedOrderServer) PickupOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupOrder not implemented")
+}
+func (UnimplementedOrderServer) ReturnOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
+}
+func (UnimplementedOrderServer) CompleteOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
+}
+func (UnimplementedOrderServer) CancelOrder(context.Context, *OrderTransitionRequest) (*SingleOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServer) ExtendOrder(context.Context, *ExtendOrderRequest) (*ExtendOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendOrder not implemented")
+}
+func (UnimplementedOrderServer) GetOrderStatusHistory(context.Context, *GetOrderStatusHistoryRequest) (*GetOrderStatusHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatusHistory not implemented")
+}
+func (UnimplementedOrderServer) WatchOrders(*GetOrderByParamRequest, Order_WatchOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrders not implemented")
+}
 func (UnimplementedOrderServer) CreateCar(context.Context, *CreateCarRequest) (*SingleCarReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
 }
@@ -196,6 +438,27 @@ func (UnimplementedOrderServer) GetCarByID(context.Context, *GetCarByIDRequest)
 func (UnimplementedOrderServer) GetCarByParam(context.Context, *GetCarByParamRequest) (*GetCarByParamReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCarByParam not implemented")
 }
+func (UnimplementedOrderServer) GetAvailableCars(context.Context, *GetAvailableCarsRequest) (*GetCarByParamReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableCars not implemented")
+}
+func (UnimplementedOrderServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*SingleWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedOrderServer) GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedOrderServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedOrderServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedOrderServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*WebhookDeliveryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedOrderServer) GetAuditLogs(context.Context, *GetAuditLogsRequest) (*GetAuditLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogs not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 
 // UnsafeOrderServer may be embedded to opt out of forward compatibility for this service.
@@ -299,6 +562,171 @@ func _Order_GetOrderByParam_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).QuoteOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ConfirmOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ConfirmOrder(ctx, req.(*OrderTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_PickupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PickupOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PickupOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PickupOrder(ctx, req.(*OrderTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ReturnOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReturnOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReturnOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReturnOrder(ctx, req.(*OrderTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CompleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CompleteOrder(ctx, req.(*OrderTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CancelOrder(ctx, req.(*OrderTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ExtendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ExtendOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ExtendOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ExtendOrder(ctx, req.(*ExtendOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetOrderStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrderStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrderStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrderStatusHistory(ctx, req.(*GetOrderStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_WatchOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderByParamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).WatchOrders(m, &orderWatchOrdersServer{stream})
+}
+
+type Order_WatchOrdersServer interface {
+	Send(*OrderEventReply) error
+	grpc.ServerStream
+}
+
+type orderWatchOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderWatchOrdersServer) Send(m *OrderEventReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Order_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCarRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +817,132 @@ func _Order_GetCarByParam_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_GetAvailableCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetAvailableCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetAvailableCars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetAvailableCars(ctx, req.(*GetAvailableCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetWebhooks(ctx, req.(*GetWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReplayWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetAuditLogs(ctx, req.(*GetAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -416,6 +970,38 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderByParam",
 			Handler:    _Order_GetOrderByParam_Handler,
 		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _Order_QuoteOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _Order_ConfirmOrder_Handler,
+		},
+		{
+			MethodName: "PickupOrder",
+			Handler:    _Order_PickupOrder_Handler,
+		},
+		{
+			MethodName: "ReturnOrder",
+			Handler:    _Order_ReturnOrder_Handler,
+		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _Order_CompleteOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Order_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ExtendOrder",
+			Handler:    _Order_ExtendOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderStatusHistory",
+			Handler:    _Order_GetOrderStatusHistory_Handler,
+		},
 		{
 			MethodName: "CreateCar",
 			Handler:    _Order_CreateCar_Handler,
@@ -436,7 +1022,41 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCarByParam",
 			Handler:    _Order_GetCarByParam_Handler,
 		},
+		{
+			MethodName: "GetAvailableCars",
+			Handler:    _Order_GetAvailableCars_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Order_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _Order_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Order_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _Order_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _Order_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "GetAuditLogs",
+			Handler:    _Order_GetAuditLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrders",
+			Handler:       _Order_WatchOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	IdempotencyOrderKey string = "idemOrder:%d:%s"
+	IdempotencyCarKey   string = "idemCar:%d:%s"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	IdempotencyStatusInProgress = "in_progress"
+	IdempotencyStatusDone       = "done"
+
+	// IdempotencyExpiration is how long a key and its response are kept, a
+	// retry after this window is treated as a new request.
+	IdempotencyExpiration time.Duration = 24 * time.Hour
+)
+
+type IdempotencyRecord struct {
+	Status      string          `json:"status"`
+	RequestHash string          `json:"request_hash"`
+	Response    json.RawMessage `json:"response,omitempty"`
+}
+
+// GetRequestHash returns the fingerprint of a request, used to detect an
+// idempotency key reused for a different request. The idempotency key itself
+// must be cleared from v beforehand.
+func GetRequestHash(v proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(v)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal request")
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ValidateIdempotencyRecord returns error when the stored record can not be
+// replayed for a request with the given hash.
+func ValidateIdempotencyRecord(record IdempotencyRecord, requestHash string) error {
+	if record.RequestHash != requestHash {
+		return errormsg.WrapErr(svcerr.OrderSVCIdempotencyMismatch, nil, "idempotency key used for different request")
+	}
+
+	if record.Status != IdempotencyStatusDone {
+		return errormsg.WrapErr(svcerr.OrderSVCIdempotencyProgress, nil, "idempotency key in progress")
+	}
+
+	return nil
+}
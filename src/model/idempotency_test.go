@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateIdempotencyRecord(t *testing.T) {
+	Convey("test validate idempotency record", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			record   model.IdempotencyRecord
+			wantCode int64
+		}{
+			{testType: "P", testDesc: "done record with same request", record: model.IdempotencyRecord{Status: model.IdempotencyStatusDone, RequestHash: "abc"}},
+			{testType: "N", testDesc: "record still in progress", record: model.IdempotencyRecord{Status: model.IdempotencyStatusInProgress, RequestHash: "abc"}, wantCode: svcerr.CodeIdempotencyInProgress},
+			{testType: "N", testDesc: "record of different request", record: model.IdempotencyRecord{Status: model.IdempotencyStatusDone, RequestHash: "def"}, wantCode: svcerr.CodeIdempotencyKeyMismatch},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := model.ValidateIdempotencyRecord(test.record, "abc")
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDMetadata and SourceMetadata carry the audit info of a rest
+	// request to the grpc service, AuditSignatureMetadata proves it was
+	// sent by the rest server.
+	RequestIDMetadata      = "x-request-id"
+	SourceMetadata         = "x-source"
+	AuditSignatureMetadata = "x-audit-signature"
+
+	// AuthorizationMetadata carries the token of a rest request to the grpc
+	// service, the principal is read from it once it is verified.
+	AuthorizationMetadata = "authorization"
+
+	// AuditSignatureKey is the gin key of the signature of the audit info of
+	// a rest request.
+	AuditSignatureKey = "audit_signature"
+
+	bearerPrefix = "Bearer "
+)
+
+type tokenKey struct{}
+
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// GetToken returns the token put into ctx by WithToken or, for a rest
+// request, the bearer token of its authorization header.
+func GetToken(ctx context.Context) string {
+	if token, ok := ctx.Value(tokenKey{}).(string); ok {
+		return token
+	}
+
+	if c, ok := ctx.Value(gin.ContextKey).(*gin.Context); ok {
+		return getBearerToken(c.GetHeader(AuthorizationMetadata))
+	}
+
+	return ""
+}
+
+func getBearerToken(v string) string {
+	if !strings.HasPrefix(v, bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(v, bearerPrefix)
+}
+
+// GetAuditSignature returns the hex HMAC-SHA256 of the audit info sent with
+// token keyed with secret, the info can not be sent with another token.
+func GetAuditSignature(secret []byte, token string, info AuditInfo) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(token + "." + info.RequestID + "." + info.Source))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewOutgoingContext returns ctx with the token and the signed audit info of
+// the rest request attached as grpc metadata, a grpc client call made with it
+// is authorized and audited as the same request.
+func NewOutgoingContext(ctx context.Context) context.Context {
+	var kv []string
+	if c, ok := ctx.Value(gin.ContextKey).(*gin.Context); ok && c.GetString(AuditSignatureKey) != "" {
+		kv = append(kv,
+			RequestIDMetadata, c.GetHeader(RequestIDMetadata),
+			SourceMetadata, AuditSourceREST,
+			AuditSignatureMetadata, c.GetString(AuditSignatureKey),
+		)
+	}
+
+	if token := GetToken(ctx); token != "" {
+		kv = append(kv, AuthorizationMetadata, bearerPrefix+token)
+	}
+
+	if len(kv) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// NewIncomingContext returns ctx with the audit info and the principal sent by
+// a grpc client, both are checked with secret. The principal is read from a
+// valid token only, calls without one are not authorized to anything. Audit
+// info without a valid signature is dropped and the call is audited as made
+// by a grpc client directly.
+func NewIncomingContext(ctx context.Context, secret []byte) context.Context {
+	info := AuditInfo{
+		Source: AuditSourceGRPC,
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return WithAuditInfo(ctx, info)
+	}
+
+	token := getBearerToken(getMetadata(md, AuthorizationMetadata))
+	sent := AuditInfo{
+		RequestID: getMetadata(md, RequestIDMetadata),
+		Source:    getMetadata(md, SourceMetadata),
+	}
+	signature := getMetadata(md, AuditSignatureMetadata)
+	if sent.Source != "" && hmac.Equal([]byte(signature), []byte(GetAuditSignature(secret, token, sent))) {
+		info = sent
+	}
+	ctx = WithAuditInfo(ctx, info)
+
+	principal, err := ParseToken(secret, token)
+	if err != nil {
+		return ctx
+	}
+
+	return WithPrincipal(ctx, principal)
+}
+
+func getMetadata(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+
+	return ""
+}
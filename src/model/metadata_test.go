@@ -0,0 +1,75 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrincipalMetadata(t *testing.T) {
+	secret := []byte("s3cr3t")
+	sign := func(key []byte, claims jwt.MapClaims) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+		return token
+	}
+
+	Convey("test principal travel as grpc metadata", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			md       metadata.MD
+			want     model.Principal
+		}{
+			{
+				testType: "P",
+				testDesc: "token verified with secret",
+				md:       metadata.Pairs(model.AuthorizationMetadata, "Bearer "+sign(secret, jwt.MapClaims{"id": 5, "scope": model.CustomerScope})),
+				want:     model.Principal{ID: 5, Scope: model.CustomerScope},
+			},
+			{
+				testType: "N",
+				testDesc: "token signed with another secret",
+				md:       metadata.Pairs(model.AuthorizationMetadata, "Bearer "+sign([]byte("other"), jwt.MapClaims{"id": 5, "scope": model.SuperAdminScope})),
+			},
+			{
+				testType: "N",
+				testDesc: "token without scope",
+				md:       metadata.Pairs(model.AuthorizationMetadata, "Bearer "+sign(secret, jwt.MapClaims{"id": 5})),
+			},
+			{
+				testType: "N",
+				testDesc: "user id and scope sent without token",
+				md:       metadata.Pairs("x-user-id", "5", "x-user-scope", model.SuperAdminScope),
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				principal, ok := model.GetPrincipal(model.NewIncomingContext(metadata.NewIncomingContext(context.Background(), test.md), secret))
+				So(ok, ShouldEqual, test.testType == "P")
+				So(principal, ShouldResemble, test.want)
+			})
+		}
+
+		Convey("4 - [P] : test rest token forwarded to grpc service", func() {
+			token := sign(secret, jwt.MapClaims{"id": 7, "scope": model.StoreScope})
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/api/order", nil)
+			c.Request.Header.Set("Authorization", "Bearer "+token)
+
+			out, _ := metadata.FromOutgoingContext(model.NewOutgoingContext(c))
+			So(out.Get(model.AuthorizationMetadata), ShouldResemble, []string{"Bearer " + token})
+
+			principal, ok := model.GetPrincipal(model.NewIncomingContext(metadata.NewIncomingContext(context.Background(), out), secret))
+			So(ok, ShouldBeTrue)
+			So(principal, ShouldResemble, model.Principal{ID: 7, Scope: model.StoreScope})
+		})
+	})
+}
@@ -15,23 +15,64 @@ import (
 )
 
 var (
-	GetSingleByParamOrderKey string = "gspOrder:%s"
-	GetByParamOrderKey       string = "gpOrder:%s"
-	GetByParamOrderPgKey     string = "gppgOrder:%s"
+	GetSingleByParamOrderKey string = "gspOrder:%d:%s"
+	GetByParamOrderKey       string = "gpOrder:%d:%s"
+	GetByParamOrderPgKey     string = "gppgOrder:%d:%s"
+
+	// OrderCacheNamespaceKey holds the version of every cached order entry, it
+	// is part of each cache key so bumping it bypasses all of them at once.
+	OrderCacheNamespaceKey string = "nsOrder"
 )
 
 type GetOrderByParam struct {
 	ID              null.Int64   `schema:"id" json:"id"`
-	CarID           null.Int64   `json:"car_id"`
-	OrderDate       null.Time    `json:"order_date"`
-	PickupDate      null.Time    `json:"pickup_date"`
-	DropoffDate     null.Time    `json:"dropoff_date"`
-	PickupLocation  null.String  `json:"pickup_location"`
-	PickupLat       null.Float64 `json:"pickup_lat"`
-	PickupLong      null.Float64 `json:"pickup_long"`
-	DropoffLocation null.String  `json:"dropoff_location"`
-	DropoffLat      null.Float64 `json:"dropoff_lat"`
-	DropoffLong     null.Float64 `json:"dropoff_long"`
+	CarID           null.Int64   `schema:"car_id" json:"car_id"`
+	OrderDate       null.Time    `schema:"order_date" json:"order_date"`
+	PickupDate      null.Time    `schema:"pickup_date" json:"pickup_date"`
+	DropoffDate     null.Time    `schema:"dropoff_date" json:"dropoff_date"`
+	PickupLocation  null.String  `schema:"pickup_location" json:"pickup_location"`
+	PickupLat       null.Float64 `schema:"pickup_lat" json:"pickup_lat"`
+	PickupLong      null.Float64 `schema:"pickup_long" json:"pickup_long"`
+	DropoffLocation null.String  `schema:"dropoff_location" json:"dropoff_location"`
+	DropoffLat      null.Float64 `schema:"dropoff_lat" json:"dropoff_lat"`
+	DropoffLong     null.Float64 `schema:"dropoff_long" json:"dropoff_long"`
+	IsOverdue       null.Bool    `schema:"is_overdue" json:"is_overdue"`
+	// StartDate and EndDate keep orders whose rental overlaps the window,
+	// either side may be left open.
+	StartDate null.Time `schema:"start_date" json:"start_date"`
+	EndDate   null.Time `schema:"end_date" json:"end_date"`
+	// The from and to dates keep orders whose date is within the range, both
+	// inclusive, either side may be left open.
+	OrderDateFrom   null.Time `schema:"order_date_from" json:"order_date_from"`
+	OrderDateTo     null.Time `schema:"order_date_to" json:"order_date_to"`
+	PickupDateFrom  null.Time `schema:"pickup_date_from" json:"pickup_date_from"`
+	PickupDateTo    null.Time `schema:"pickup_date_to" json:"pickup_date_to"`
+	DropoffDateFrom null.Time `schema:"dropoff_date_from" json:"dropoff_date_from"`
+	DropoffDateTo   null.Time `schema:"dropoff_date_to" json:"dropoff_date_to"`
+	CreatedAtFrom   null.Time `schema:"created_at_from" json:"created_at_from"`
+	CreatedAtTo     null.Time `schema:"created_at_to" json:"created_at_to"`
+	// CarIDIn and StatusIn keep orders of any of the cars and statuses.
+	CarIDIn  []int64  `schema:"car_id_in" json:"car_id_in"`
+	StatusIn []string `schema:"status_in" json:"status_in"`
+	// The near fields keep orders within the radius in km of the point and the
+	// min and max fields keep orders inside the box, see GeoFilter.
+	PickupNearLat   null.Float64 `schema:"pickup_near_lat" json:"pickup_near_lat"`
+	PickupNearLong  null.Float64 `schema:"pickup_near_long" json:"pickup_near_long"`
+	PickupRadiusKM  null.Float64 `schema:"pickup_radius_km" json:"pickup_radius_km"`
+	PickupMinLat    null.Float64 `schema:"pickup_min_lat" json:"pickup_min_lat"`
+	PickupMaxLat    null.Float64 `schema:"pickup_max_lat" json:"pickup_max_lat"`
+	PickupMinLong   null.Float64 `schema:"pickup_min_long" json:"pickup_min_long"`
+	PickupMaxLong   null.Float64 `schema:"pickup_max_long" json:"pickup_max_long"`
+	DropoffNearLat  null.Float64 `schema:"dropoff_near_lat" json:"dropoff_near_lat"`
+	DropoffNearLong null.Float64 `schema:"dropoff_near_long" json:"dropoff_near_long"`
+	DropoffRadiusKM null.Float64 `schema:"dropoff_radius_km" json:"dropoff_radius_km"`
+	DropoffMinLat   null.Float64 `schema:"dropoff_min_lat" json:"dropoff_min_lat"`
+	DropoffMaxLat   null.Float64 `schema:"dropoff_max_lat" json:"dropoff_max_lat"`
+	DropoffMinLong  null.Float64 `schema:"dropoff_min_long" json:"dropoff_min_long"`
+	DropoffMaxLong  null.Float64 `schema:"dropoff_max_long" json:"dropoff_max_long"`
+	// CreatedBy is set from the principal by the policy, it keeps customers
+	// to their own orders and is part of the cache key.
+	CreatedBy null.Int64 `schema:"-" json:"created_by"`
 }
 
 func (g *GetOrderByParam) GetQuery() []qm.QueryMod {
@@ -76,8 +117,82 @@ func (g *GetOrderByParam) GetQuery() []qm.QueryMod {
 		res = append(res, qm.Where("dropoff_lat=?", g.DropoffLat.Float64))
 	}
 
-	if g.PickupLong.Valid {
-		res = append(res, qm.Where("dropoff_long=?", g.PickupLong.Float64))
+	if g.DropoffLong.Valid {
+		res = append(res, qm.Where("dropoff_long=?", g.DropoffLong.Float64))
+	}
+
+	if g.IsOverdue.Valid {
+		res = append(res, getOverdueQuery(g.IsOverdue.Bool))
+	}
+
+	res = append(res, getWindowQuery(g.StartDate, g.EndDate)...)
+	res = append(res, getRangeQuery("order_date", g.OrderDateFrom, g.OrderDateTo)...)
+	res = append(res, getRangeQuery("pickup_date", g.PickupDateFrom, g.PickupDateTo)...)
+	res = append(res, getRangeQuery("dropoff_date", g.DropoffDateFrom, g.DropoffDateTo)...)
+	res = append(res, getRangeQuery("created_at", g.CreatedAtFrom, g.CreatedAtTo)...)
+
+	if len(g.CarIDIn) > 0 {
+		var carIDs []interface{}
+		for _, carID := range g.CarIDIn {
+			carIDs = append(carIDs, carID)
+		}
+		res = append(res, qm.WhereIn("car_id IN ?", carIDs...))
+	}
+
+	if len(g.StatusIn) > 0 {
+		var statuses []interface{}
+		for _, status := range g.StatusIn {
+			statuses = append(statuses, status)
+		}
+		res = append(res, qm.WhereIn("status IN ?", statuses...))
+	}
+
+	res = append(res, getGeoQuery("pickup_lat", "pickup_long", g.GetPickupFilter())...)
+	res = append(res, getGeoQuery("dropoff_lat", "dropoff_long", g.GetDropoffFilter())...)
+
+	if g.CreatedBy.Valid {
+		res = append(res, qm.Where("created_by=?", g.CreatedBy.Int64))
+	}
+
+	return res
+}
+
+// GetOverlapQuery returns query of orders renting the car on any day between
+// pickup date and dropoff date, both inclusive.
+func GetOverlapQuery(carID int64, pickupDate, dropoffDate time.Time) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("car_id=?", carID),
+		qm.Where("pickup_date<=?", dropoffDate),
+		qm.Where("dropoff_date>=?", pickupDate),
+		qm.Where("status<>?", OrderStatusCancelled),
+	}
+}
+
+// getRangeQuery returns query of rows whose column is between from and to,
+// both inclusive.
+func getRangeQuery(column string, from, to null.Time) []qm.QueryMod {
+	var res []qm.QueryMod
+	if from.Valid {
+		res = append(res, qm.Where(column+">=?", from.Time))
+	}
+
+	if to.Valid {
+		res = append(res, qm.Where(column+"<=?", to.Time))
+	}
+
+	return res
+}
+
+// getWindowQuery returns query of orders renting on any day between start and
+// end, both inclusive.
+func getWindowQuery(start, end null.Time) []qm.QueryMod {
+	var res []qm.QueryMod
+	if end.Valid {
+		res = append(res, qm.Where("pickup_date<=?", end.Time))
+	}
+
+	if start.Valid {
+		res = append(res, qm.Where("dropoff_date>=?", start.Time))
 	}
 
 	return res
@@ -85,9 +200,13 @@ func (g *GetOrderByParam) GetQuery() []qm.QueryMod {
 
 type GetOrdersByParam struct {
 	GetOrderByParam
-	OrderBy null.String `schema:"order_by" json:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit"`
-	Page    int64       `schema:"page" json:"page"`
+	OrderBy  null.String `schema:"order_by" json:"order_by"`
+	Limit    int64       `schema:"limit" json:"limit"`
+	Page     int64       `schema:"page" json:"page"`
+	PageMode null.String `schema:"page_mode" json:"page_mode"`
+	Cursor   null.String `schema:"cursor" json:"cursor"`
+	Include  null.String `schema:"include" json:"include"`
+	Fields   null.String `schema:"fields" json:"fields"`
 }
 
 func (g *GetOrdersByParam) FillGrpcClient() *grpcmodel.GetOrderByParamRequest {
@@ -100,9 +219,37 @@ func (g *GetOrdersByParam) FillGrpcClient() *grpcmodel.GetOrderByParamRequest {
 		DropoffLocation: g.DropoffLocation.Ptr(),
 		DropoffLat:      g.DropoffLat.Ptr(),
 		DropoffLong:     g.DropoffLong.Ptr(),
+		IsOverdue:       g.IsOverdue.Ptr(),
+		OrderDateFrom:   formatNullTime(g.OrderDateFrom),
+		OrderDateTo:     formatNullTime(g.OrderDateTo),
+		PickupDateFrom:  formatNullTime(g.PickupDateFrom),
+		PickupDateTo:    formatNullTime(g.PickupDateTo),
+		DropoffDateFrom: formatNullTime(g.DropoffDateFrom),
+		DropoffDateTo:   formatNullTime(g.DropoffDateTo),
+		CreatedAtFrom:   formatNullTime(g.CreatedAtFrom),
+		CreatedAtTo:     formatNullTime(g.CreatedAtTo),
+		CarIdIn:         g.CarIDIn,
+		StatusIn:        g.StatusIn,
+		PickupNearLat:   g.PickupNearLat.Ptr(),
+		PickupNearLong:  g.PickupNearLong.Ptr(),
+		PickupRadiusKm:  g.PickupRadiusKM.Ptr(),
+		PickupMinLat:    g.PickupMinLat.Ptr(),
+		PickupMaxLat:    g.PickupMaxLat.Ptr(),
+		PickupMinLong:   g.PickupMinLong.Ptr(),
+		PickupMaxLong:   g.PickupMaxLong.Ptr(),
+		DropoffNearLat:  g.DropoffNearLat.Ptr(),
+		DropoffNearLong: g.DropoffNearLong.Ptr(),
+		DropoffRadiusKm: g.DropoffRadiusKM.Ptr(),
+		DropoffMinLat:   g.DropoffMinLat.Ptr(),
+		DropoffMaxLat:   g.DropoffMaxLat.Ptr(),
+		DropoffMinLong:  g.DropoffMinLong.Ptr(),
+		DropoffMaxLong:  g.DropoffMaxLong.Ptr(),
 		OrderBy:         g.OrderBy.Ptr(),
 		Limit:           g.Limit,
 		Page:            g.Page,
+		PageMode:        g.PageMode.Ptr(),
+		Cursor:          g.Cursor.Ptr(),
+		Include:         g.GetIncludes(),
 	}
 
 	if g.OrderDate.Valid {
@@ -120,60 +267,66 @@ func (g *GetOrdersByParam) FillGrpcClient() *grpcmodel.GetOrderByParamRequest {
 		res.DropoffDate = &dropoffDate
 	}
 
-	return res
-}
-
-func (g *GetOrdersByParam) GetQuery() []qm.QueryMod {
-	var res []qm.QueryMod
-	if g.ID.Valid {
-		res = append(res, qm.Where("id=?", g.ID.Int64))
+	if g.StartDate.Valid {
+		startDate := g.StartDate.Time.Format(time.RFC3339)
+		res.StartDate = &startDate
 	}
 
-	if g.CarID.Valid {
-		res = append(res, qm.Where("car_id=?", g.CarID.Int64))
+	if g.EndDate.Valid {
+		endDate := g.EndDate.Time.Format(time.RFC3339)
+		res.EndDate = &endDate
 	}
 
-	if g.OrderDate.Valid {
-		res = append(res, qm.Where("order_date=?", g.OrderDate.Time))
-	}
+	return res
+}
 
-	if g.PickupDate.Valid {
-		res = append(res, qm.Where("pickup_date=?", g.PickupDate.Time))
+// Validate returns an error when the filters, includes or fields of g are not
+// valid, its order by is not a valid sort or its cursor is not of that sort.
+// The distance sorts are not available to cursor pages.
+func (g *GetOrdersByParam) Validate() error {
+	err := g.GetOrderByParam.Validate()
+	if err != nil {
+		return err
 	}
 
-	if g.DropoffDate.Valid {
-		res = append(res, qm.Where("dropoff_date=?", g.DropoffDate.Time))
+	err = g.validateFields()
+	if err != nil {
+		return err
 	}
 
-	if g.PickupLocation.Valid {
-		res = append(res, qm.Where("pickup_location=?", g.PickupLocation.String))
+	err = validatePageMode(g.PageMode)
+	if err != nil {
+		return err
 	}
 
-	if g.PickupLat.Valid {
-		res = append(res, qm.Where("pickup_lat=?", g.PickupLat.Float64))
+	if g.IsCursor() {
+		_, err = g.GetCursorPage()
+		return err
 	}
 
-	if g.PickupLong.Valid {
-		res = append(res, qm.Where("pickup_long=?", g.PickupLong.Float64))
+	if !g.OrderBy.Valid {
+		return nil
 	}
 
-	if g.DropoffLocation.Valid {
-		res = append(res, qm.Where("dropoff_location=?", g.DropoffLocation.String))
-	}
+	_, err = ParseSort(g.OrderBy.String, g.getSortColumns())
+	return err
+}
 
-	if g.DropoffLat.Valid {
-		res = append(res, qm.Where("dropoff_lat=?", g.DropoffLat.Float64))
-	}
+// IsCursor reports whether g is paged by cursor, which a cursor implies.
+func (g *GetOrdersByParam) IsCursor() bool {
+	return g.PageMode.String == PageModeCursor || g.Cursor.Valid
+}
 
-	if g.PickupLong.Valid {
-		res = append(res, qm.Where("dropoff_long=?", g.PickupLong.Float64))
-	}
+func (g *GetOrdersByParam) GetCursorPage() (CursorPage, error) {
+	return NewCursorPage(g.OrderBy.String, g.Cursor.String, OrderSortColumns, g.Limit)
+}
 
-	if g.OrderBy.Valid {
-		order := strings.Split(g.OrderBy.String, ",")
-		for _, o := range order {
-			res = append(res, qm.OrderBy(o))
-		}
+// GetQuery returns the query of g, which is left to the cursor page to sort
+// when g is paged by cursor.
+func (g *GetOrdersByParam) GetQuery() []qm.QueryMod {
+	res := g.GetOrderByParam.GetQuery()
+	if g.OrderBy.Valid && !g.IsCursor() {
+		res = append(res, g.getSortQuery()...)
 	}
 
 	return res
@@ -191,6 +344,8 @@ type CreateOrder struct {
 	DropoffLat      float64   `json:"dropoff_lat" validate:"required,min=-90,max=90"`
 	DropoffLong     float64   `json:"dropoff_long" validate:"required,min=-180,max=180"`
 	CreatedBy       int64     `json:"-"`
+
+	IdempotencyKey string `json:"-" validate:"max=255"`
 }
 
 func (v *CreateOrder) Validate() error {
@@ -206,7 +361,7 @@ func (v *CreateOrder) Validate() error {
 		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidPickupDate, nil, "invalid pickup date")
 	}
 
-	if v.DropoffDate.IsZero() {
+	if v.DropoffDate.IsZero() || v.DropoffDate.Before(v.PickupDate) {
 		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidDropoffDate, nil, "invalid dropoff date")
 	}
 
@@ -237,6 +392,28 @@ func (v *CreateOrder) Validate() error {
 	return nil
 }
 
+func (v *CreateOrder) FillGrpcClient() *grpcmodel.CreateOrderRequest {
+	res := &grpcmodel.CreateOrderRequest{
+		CarId:           v.CarID,
+		OrderDate:       v.OrderDate.Format(time.RFC3339),
+		PickupDate:      v.PickupDate.Format(time.RFC3339),
+		DropoffDate:     v.DropoffDate.Format(time.RFC3339),
+		PickupLocation:  v.PickupLocation,
+		PickupLat:       v.PickupLat,
+		PickupLong:      v.PickupLong,
+		DropoffLocation: v.DropoffLocation,
+		DropoffLat:      v.DropoffLat,
+		DropoffLong:     v.DropoffLong,
+		CreatedBy:       v.CreatedBy,
+	}
+
+	if v.IdempotencyKey != "" {
+		res.IdempotencyKey = &v.IdempotencyKey
+	}
+
+	return res
+}
+
 type UpdateOrder struct {
 	CarID           null.Int64   `json:"car_id" swaggertype:"number" validate:"required,min=1"`
 	OrderDate       null.Time    `json:"order_date" swaggertype:"string" example:"2024-02-02T00:00:00Z" validate:"required"`
@@ -248,7 +425,8 @@ type UpdateOrder struct {
 	DropoffLocation null.String  `json:"dropoff_location" swaggertype:"string" validate:"required,min=30,max=50"`
 	DropoffLat      null.Float64 `json:"dropoff_lat" swaggertype:"number" validate:"required,min=-90,max=90"`
 	DropoffLong     null.Float64 `json:"dropoff_long" swaggertype:"number" validate:"required,min=-180,max=180"`
-	UpdatedBy       int64        `json:"-"`
+
+	ExpectedVersion *int64 `json:"-"`
 }
 
 func (v *UpdateOrder) FillGrpcClient() *grpcmodel.UpdateOrderRequest {
@@ -322,17 +500,25 @@ func (v *UpdateOrder) FillEntity(Order *psqlmodel.Order) {
 }
 
 type Order struct {
-	ID              int64     `json:"id"`
-	CarID           int64     `json:"car_id"`
-	OrderDate       time.Time `json:"order_date"`
-	PickupDate      time.Time `json:"pickup_date"`
-	DropoffDate     time.Time `json:"dropoff_date"`
-	PickupLocation  string    `json:"pickup_location"`
-	PickupLat       float64   `json:"pickup_lat"`
-	PickupLong      float64   `json:"pickup_long"`
-	DropoffLocation string    `json:"dropoff_location"`
-	DropoffLat      float64   `json:"dropoff_lat"`
-	DropoffLong     float64   `json:"dropoff_long"`
+	ID                  int64             `json:"id"`
+	CarID               int64             `json:"car_id"`
+	OrderDate           time.Time         `json:"order_date"`
+	PickupDate          time.Time         `json:"pickup_date"`
+	DropoffDate         time.Time         `json:"dropoff_date"`
+	OriginalDropoffDate null.Time         `json:"original_dropoff_date" swaggertype:"string"`
+	PickupLocation      string            `json:"pickup_location"`
+	PickupLat           float64           `json:"pickup_lat"`
+	PickupLong          float64           `json:"pickup_long"`
+	DropoffLocation     string            `json:"dropoff_location"`
+	DropoffLat          float64           `json:"dropoff_lat"`
+	DropoffLong         float64           `json:"dropoff_long"`
+	Price               OrderPrice        `json:"price"`
+	Status              string            `json:"status"`
+	OverdueAt           null.Time         `json:"overdue_at" swaggertype:"string"`
+	LateFee             float64           `json:"late_fee"`
+	Version             int64             `json:"version"`
+	Cancellation        OrderCancellation `json:"cancellation"`
+	Car                 *Car              `json:"car,omitempty"`
 	BaseInformation
 }
 
@@ -357,18 +543,25 @@ func TransformPSQLSingleOrder(order *psqlmodel.Order) Order {
 	}
 
 	return Order{
-		ID:              int64(order.ID),
-		CarID:           int64(order.CarID),
-		OrderDate:       order.OrderDate,
-		PickupDate:      order.PickupDate,
-		DropoffDate:     order.DropoffDate,
-		PickupLocation:  order.PickupLocation,
-		PickupLat:       order.PickupLat,
-		PickupLong:      order.PickupLong,
-		DropoffLocation: order.DropoffLocation,
-		DropoffLat:      order.DropoffLat,
-		DropoffLong:     order.DropoffLong,
-		BaseInformation: creationInfo,
+		ID:                  int64(order.ID),
+		CarID:               int64(order.CarID),
+		OrderDate:           order.OrderDate,
+		PickupDate:          order.PickupDate,
+		DropoffDate:         order.DropoffDate,
+		OriginalDropoffDate: order.OriginalDropoffDate,
+		PickupLocation:      order.PickupLocation,
+		PickupLat:           order.PickupLat,
+		PickupLong:          order.PickupLong,
+		DropoffLocation:     order.DropoffLocation,
+		DropoffLat:          order.DropoffLat,
+		DropoffLong:         order.DropoffLong,
+		Price:               TransformPSQLOrderPrice(order),
+		Status:              order.Status,
+		OverdueAt:           order.OverdueAt,
+		LateFee:             order.LateFee,
+		Version:             int64(order.Version),
+		Cancellation:        TransformPSQLOrderCancellation(order),
+		BaseInformation:     creationInfo,
 	}
 }
 
@@ -394,18 +587,25 @@ func TransformPSQLOrder(order *psqlmodel.OrderSlice) []Order {
 		}
 
 		res = append(res, Order{
-			ID:              int64(v.ID),
-			CarID:           int64(v.CarID),
-			OrderDate:       v.OrderDate,
-			PickupDate:      v.PickupDate,
-			DropoffDate:     v.DropoffDate,
-			PickupLocation:  v.PickupLocation,
-			PickupLat:       v.PickupLat,
-			PickupLong:      v.PickupLong,
-			DropoffLocation: v.DropoffLocation,
-			DropoffLat:      v.DropoffLat,
-			DropoffLong:     v.DropoffLong,
-			BaseInformation: creationInfo,
+			ID:                  int64(v.ID),
+			CarID:               int64(v.CarID),
+			OrderDate:           v.OrderDate,
+			PickupDate:          v.PickupDate,
+			DropoffDate:         v.DropoffDate,
+			OriginalDropoffDate: v.OriginalDropoffDate,
+			PickupLocation:      v.PickupLocation,
+			PickupLat:           v.PickupLat,
+			PickupLong:          v.PickupLong,
+			DropoffLocation:     v.DropoffLocation,
+			DropoffLat:          v.DropoffLat,
+			DropoffLong:         v.DropoffLong,
+			Price:               TransformPSQLOrderPrice(v),
+			Status:              v.Status,
+			OverdueAt:           v.OverdueAt,
+			LateFee:             v.LateFee,
+			Version:             int64(v.Version),
+			Cancellation:        TransformPSQLOrderCancellation(v),
+			BaseInformation:     creationInfo,
 		})
 	}
 
@@ -425,6 +625,10 @@ func TransformSingleOrderReply(v *psqlmodel.Order) *grpcmodel.SingleOrderReply {
 		DropoffLocation: v.DropoffLocation,
 		DropoffLat:      v.DropoffLat,
 		DropoffLong:     v.DropoffLong,
+		Price:           TransformOrderPriceReply(v),
+		Status:          v.Status,
+		LateFee:         v.LateFee,
+		Version:         int64(v.Version),
 		CreatedBy:       int64(v.CreatedBy),
 		CreatedAt:       v.CreatedAt.Format(time.RFC3339),
 		UpdatedBy:       int64(v.UpdatedBy),
@@ -441,14 +645,57 @@ func TransformSingleOrderReply(v *psqlmodel.Order) *grpcmodel.SingleOrderReply {
 		singleOrder.DeletedAt = &deletedAt
 	}
 
+	if v.OriginalDropoffDate.Valid {
+		originalDropoffDate := v.OriginalDropoffDate.Time.Format(time.RFC3339)
+		singleOrder.OriginalDropoffDate = &originalDropoffDate
+	}
+
+	if v.OverdueAt.Valid {
+		overdueAt := v.OverdueAt.Time.Format(time.RFC3339)
+		singleOrder.OverdueAt = &overdueAt
+	}
+
+	if v.R != nil && v.R.Car != nil {
+		singleOrder.Car = TransformSingleCarReply(v.R.Car)
+	}
+
+	fillOrderCancellationReply(v, singleOrder)
 	return singleOrder
 }
 
+// formatNullTime returns t in RFC3339, or nil when t is not set.
+func formatNullTime(t null.Time) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	res := t.Time.Format(time.RFC3339)
+	return &res
+}
+
+// parseNullTime returns the RFC3339 time v, which is not set when v is nil or
+// can not be parsed.
+func parseNullTime(ctx context.Context, v *string, log logger.Logger) null.Time {
+	if v == nil {
+		return null.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		log.Error(ctx, err)
+		return null.Time{}
+	}
+
+	return null.TimeFrom(t)
+}
+
 func TransformGetOrderByParamRequestToOrderParam(ctx context.Context, v *grpcmodel.GetOrderByParamRequest, log logger.Logger) GetOrdersByParam {
 	var (
 		pickupDate  null.Time
 		dropoffDate null.Time
 		orderDate   null.Time
+		startDate   null.Time
+		endDate     null.Time
 	)
 	if v.PickupDate != nil {
 		pDate, err := time.Parse(time.RFC3339, *v.PickupDate)
@@ -471,6 +718,20 @@ func TransformGetOrderByParamRequestToOrderParam(ctx context.Context, v *grpcmod
 		}
 		orderDate = null.TimeFrom(oDate)
 	}
+	if v.StartDate != nil {
+		sDate, err := time.Parse(time.RFC3339, *v.StartDate)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+		startDate = null.TimeFrom(sDate)
+	}
+	if v.EndDate != nil {
+		eDate, err := time.Parse(time.RFC3339, *v.EndDate)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+		endDate = null.TimeFrom(eDate)
+	}
 	return GetOrdersByParam{
 		GetOrderByParam: GetOrderByParam{
 			ID:              null.Int64FromPtr(v.Id),
@@ -484,49 +745,47 @@ func TransformGetOrderByParamRequestToOrderParam(ctx context.Context, v *grpcmod
 			DropoffLocation: null.StringFromPtr(v.DropoffLocation),
 			DropoffLat:      null.Float64FromPtr(v.DropoffLat),
 			DropoffLong:     null.Float64FromPtr(v.DropoffLong),
+			IsOverdue:       null.BoolFromPtr(v.IsOverdue),
+			StartDate:       startDate,
+			EndDate:         endDate,
+			OrderDateFrom:   parseNullTime(ctx, v.OrderDateFrom, log),
+			OrderDateTo:     parseNullTime(ctx, v.OrderDateTo, log),
+			PickupDateFrom:  parseNullTime(ctx, v.PickupDateFrom, log),
+			PickupDateTo:    parseNullTime(ctx, v.PickupDateTo, log),
+			DropoffDateFrom: parseNullTime(ctx, v.DropoffDateFrom, log),
+			DropoffDateTo:   parseNullTime(ctx, v.DropoffDateTo, log),
+			CreatedAtFrom:   parseNullTime(ctx, v.CreatedAtFrom, log),
+			CreatedAtTo:     parseNullTime(ctx, v.CreatedAtTo, log),
+			CarIDIn:         v.CarIdIn,
+			StatusIn:        v.StatusIn,
+			PickupNearLat:   null.Float64FromPtr(v.PickupNearLat),
+			PickupNearLong:  null.Float64FromPtr(v.PickupNearLong),
+			PickupRadiusKM:  null.Float64FromPtr(v.PickupRadiusKm),
+			PickupMinLat:    null.Float64FromPtr(v.PickupMinLat),
+			PickupMaxLat:    null.Float64FromPtr(v.PickupMaxLat),
+			PickupMinLong:   null.Float64FromPtr(v.PickupMinLong),
+			PickupMaxLong:   null.Float64FromPtr(v.PickupMaxLong),
+			DropoffNearLat:  null.Float64FromPtr(v.DropoffNearLat),
+			DropoffNearLong: null.Float64FromPtr(v.DropoffNearLong),
+			DropoffRadiusKM: null.Float64FromPtr(v.DropoffRadiusKm),
+			DropoffMinLat:   null.Float64FromPtr(v.DropoffMinLat),
+			DropoffMaxLat:   null.Float64FromPtr(v.DropoffMaxLat),
+			DropoffMinLong:  null.Float64FromPtr(v.DropoffMinLong),
+			DropoffMaxLong:  null.Float64FromPtr(v.DropoffMaxLong),
 		},
-		OrderBy: null.StringFromPtr(v.OrderBy),
-		Limit:   v.Limit,
-		Page:    v.Page,
+		OrderBy:  null.StringFromPtr(v.OrderBy),
+		Limit:    v.Limit,
+		Page:     v.Page,
+		PageMode: null.StringFromPtr(v.PageMode),
+		Cursor:   null.StringFromPtr(v.Cursor),
+		Include:  null.NewString(strings.Join(v.Include, ","), len(v.Include) > 0),
 	}
 }
 
 func TransformOrderToGetOrderByParamReply(v *psqlmodel.OrderSlice, p Pagination) *grpcmodel.GetOrderByParamReply {
-	var (
-		data      []*grpcmodel.SingleOrderReply
-		deletedBy *int64
-		deletedAt *string
-	)
+	var data []*grpcmodel.SingleOrderReply
 	for _, val := range *v {
-		deletedBy = nil
-		if val.DeletedBy.Valid {
-			iParse := int64(val.DeletedBy.Int)
-			deletedBy = &iParse
-		}
-
-		if val.DeletedAt.Valid {
-			tParse := val.DeletedAt.Time.Format(time.RFC3339)
-			deletedAt = &tParse
-		}
-		data = append(data, &grpcmodel.SingleOrderReply{
-			Id:              int64(val.ID),
-			CarId:           int64(val.CarID),
-			OrderDate:       val.OrderDate.Format(time.RFC3339),
-			PickupDate:      val.PickupDate.Format(time.RFC3339),
-			DropoffDate:     val.DropoffDate.Format(time.RFC3339),
-			PickupLocation:  val.PickupLocation,
-			PickupLat:       val.PickupLat,
-			PickupLong:      val.PickupLong,
-			DropoffLocation: val.DropoffLocation,
-			DropoffLat:      val.DropoffLat,
-			DropoffLong:     val.DropoffLong,
-			CreatedBy:       int64(val.CreatedBy),
-			CreatedAt:       val.CreatedAt.Format(time.RFC3339),
-			UpdatedBy:       int64(val.UpdatedBy),
-			UpdatedAt:       val.UpdatedAt.Format(time.RFC3339),
-			DeletedBy:       deletedBy,
-			DeletedAt:       deletedAt,
-		})
+		data = append(data, TransformSingleOrderReply(val))
 	}
 
 	return &grpcmodel.GetOrderByParamReply{
@@ -537,6 +796,8 @@ func TransformOrderToGetOrderByParamReply(v *psqlmodel.OrderSlice, p Pagination)
 			TotalPages:     p.TotalPages,
 			TotalElements:  p.TotalElements,
 			SortBy:         p.SortBy,
+			NextCursor:     p.NextCursor,
+			PrevCursor:     p.PrevCursor,
 		},
 	}
 }
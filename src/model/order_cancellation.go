@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/volatiletech/null/v8"
+)
+
+type CancelOrder struct {
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+type OrderCancellation struct {
+	Fee         float64     `json:"fee"`
+	Reason      null.String `json:"reason" swaggertype:"string"`
+	CancelledBy null.Int64  `json:"cancelled_by" swaggertype:"integer"`
+	CancelledAt null.Time   `json:"cancelled_at" swaggertype:"string"`
+}
+
+// CalculateCancellationFee returns the fee charged for cancelling an order at
+// time now. Cancelling is free until freeBefore ahead of the pickup date,
+// after that feePercentage of the subtotal is charged.
+func CalculateCancellationFee(now, pickupDate time.Time, subtotal float64, freeBefore time.Duration, feePercentage float64) float64 {
+	if now.Before(pickupDate.Add(-freeBefore)) {
+		return 0
+	}
+
+	return math.Round(subtotal*feePercentage) / 100
+}
+
+func TransformPSQLOrderCancellation(order *psqlmodel.Order) OrderCancellation {
+	cancelledBy := null.Int64{}
+	if order.CancelledBy.Valid {
+		cancelledBy = null.Int64From(int64(order.CancelledBy.Int))
+	}
+
+	return OrderCancellation{
+		Fee:         order.CancellationFee,
+		Reason:      order.CancellationReason,
+		CancelledBy: cancelledBy,
+		CancelledAt: order.CancelledAt,
+	}
+}
+
+func fillOrderCancellationReply(order *psqlmodel.Order, reply *grpcmodel.SingleOrderReply) {
+	reply.CancellationFee = order.CancellationFee
+	reply.CancellationReason = order.CancellationReason.Ptr()
+	if order.CancelledBy.Valid {
+		cancelledBy := int64(order.CancelledBy.Int)
+		reply.CancelledBy = &cancelledBy
+	}
+
+	if order.CancelledAt.Valid {
+		cancelledAt := order.CancelledAt.Time.Format(time.RFC3339)
+		reply.CancelledAt = &cancelledAt
+	}
+}
+
+func TransformOrderCancellationReplyToOrderCancellation(ctx context.Context, v *grpcmodel.SingleOrderReply, log logger.Logger) OrderCancellation {
+	cancelledAt := null.Time{}
+	if v.CancelledAt != nil {
+		tParse, err := time.Parse(time.RFC3339, *v.CancelledAt)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		cancelledAt = null.TimeFrom(tParse)
+	}
+
+	return OrderCancellation{
+		Fee:         v.CancellationFee,
+		Reason:      null.StringFromPtr(v.CancellationReason),
+		CancelledBy: null.Int64FromPtr(v.CancelledBy),
+		CancelledAt: cancelledAt,
+	}
+}
@@ -0,0 +1,34 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCalculateCancellationFee(t *testing.T) {
+	pickupDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	Convey("test calculate cancellation fee", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			now      time.Time
+			want     float64
+		}{
+			{testType: "P", testDesc: "cancel before free window is free", now: pickupDate.Add(-48 * time.Hour), want: 0},
+			{testType: "P", testDesc: "cancel right at free window is charged", now: pickupDate.Add(-24 * time.Hour), want: 50000},
+			{testType: "P", testDesc: "cancel inside free window is charged", now: pickupDate.Add(-2 * time.Hour), want: 50000},
+			{testType: "P", testDesc: "cancel after pickup date is charged", now: pickupDate.Add(2 * time.Hour), want: 50000},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				fee := model.CalculateCancellationFee(test.now, pickupDate, 500000, 24*time.Hour, 10)
+				So(fee, ShouldEqual, test.want)
+			})
+		}
+	})
+}
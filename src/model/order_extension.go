@@ -0,0 +1,61 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+type ExtendOrder struct {
+	DropoffDate time.Time `json:"dropoff_date" example:"2024-02-05T00:00:00Z" validate:"required"`
+}
+
+func (v *ExtendOrder) FillGrpcClient() *grpcmodel.ExtendOrderRequest {
+	return &grpcmodel.ExtendOrderRequest{
+		DropoffDate: v.DropoffDate.Format(time.RFC3339),
+	}
+}
+
+type OrderExtension struct {
+	Order      Order   `json:"order"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+// ValidateOrderExtendable returns error when the rental of an order can no
+// longer be extended, that is once the car has been returned or the order is
+// closed.
+func ValidateOrderExtendable(status string) error {
+	switch status {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusPickedUp:
+		return nil
+	case OrderStatusCancelled:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCancelled, nil, "order is cancelled")
+	case OrderStatusCompleted:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCompleted, nil, "order is completed")
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCOrderNotExtendable, nil, "order is not extendable")
+}
+
+func TransformExtendOrderReply(v *psqlmodel.Order, priceDelta float64) *grpcmodel.ExtendOrderReply {
+	return &grpcmodel.ExtendOrderReply{
+		Order:      TransformSingleOrderReply(v),
+		PriceDelta: priceDelta,
+	}
+}
+
+func TransformExtendOrderReplyToOrderExtension(ctx context.Context, v *grpcmodel.ExtendOrderReply, log logger.Logger) OrderExtension {
+	if v == nil {
+		return OrderExtension{}
+	}
+
+	return OrderExtension{
+		Order:      TransformSingleOrderReplyToOrder(ctx, v.Order, log),
+		PriceDelta: v.PriceDelta,
+	}
+}
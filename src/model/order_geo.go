@@ -0,0 +1,247 @@
+package model
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// EarthRadius is the mean radius of the earth in km.
+	EarthRadius float64 = 6371
+
+	// PickupDistanceSort and DropoffDistanceSort sort orders by the distance
+	// of their location to the point of the near filter, they are only valid
+	// along with it.
+	PickupDistanceSort  = "pickup_distance"
+	DropoffDistanceSort = "dropoff_distance"
+
+	kmPerDegree float64 = math.Pi * EarthRadius / 180
+)
+
+// GeoFilter keeps orders whose location is within RadiusKM of the near point
+// and inside the box of the min and max coordinates, every part is optional
+// but a near point needs all of its fields and a box all four sides.
+type GeoFilter struct {
+	NearLat  null.Float64
+	NearLong null.Float64
+	RadiusKM null.Float64
+	MinLat   null.Float64
+	MaxLat   null.Float64
+	MinLong  null.Float64
+	MaxLong  null.Float64
+}
+
+// GetPickupFilter returns the geo filter of the pickup location of g.
+func (g *GetOrderByParam) GetPickupFilter() GeoFilter {
+	return GeoFilter{
+		NearLat:  g.PickupNearLat,
+		NearLong: g.PickupNearLong,
+		RadiusKM: g.PickupRadiusKM,
+		MinLat:   g.PickupMinLat,
+		MaxLat:   g.PickupMaxLat,
+		MinLong:  g.PickupMinLong,
+		MaxLong:  g.PickupMaxLong,
+	}
+}
+
+// GetDropoffFilter returns the geo filter of the dropoff location of g.
+func (g *GetOrderByParam) GetDropoffFilter() GeoFilter {
+	return GeoFilter{
+		NearLat:  g.DropoffNearLat,
+		NearLong: g.DropoffNearLong,
+		RadiusKM: g.DropoffRadiusKM,
+		MinLat:   g.DropoffMinLat,
+		MaxLat:   g.DropoffMaxLat,
+		MinLong:  g.DropoffMinLong,
+		MaxLong:  g.DropoffMaxLong,
+	}
+}
+
+// Validate returns an error when the geo filters of g are incomplete or out
+// of range.
+func (g *GetOrderByParam) Validate() error {
+	err := g.GetPickupFilter().Validate()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidGeoFilter, err, "invalid pickup location filter")
+	}
+
+	err = g.GetDropoffFilter().Validate()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidGeoFilter, err, "invalid dropoff location filter")
+	}
+
+	return nil
+}
+
+func (f GeoFilter) HasNear() bool {
+	return f.NearLat.Valid || f.NearLong.Valid || f.RadiusKM.Valid
+}
+
+func (f GeoFilter) HasBox() bool {
+	return f.MinLat.Valid || f.MaxLat.Valid || f.MinLong.Valid || f.MaxLong.Valid
+}
+
+func (f GeoFilter) Validate() error {
+	if f.HasNear() {
+		if !f.NearLat.Valid || !f.NearLong.Valid || !f.RadiusKM.Valid {
+			return fmt.Errorf("near filter needs lat, long and radius")
+		}
+
+		if !isLat(f.NearLat.Float64) || !isLong(f.NearLong.Float64) {
+			return fmt.Errorf("near point is out of range")
+		}
+
+		if !(f.RadiusKM.Float64 > 0) {
+			return fmt.Errorf("radius should be greater than 0")
+		}
+	}
+
+	if f.HasBox() {
+		if !f.MinLat.Valid || !f.MaxLat.Valid || !f.MinLong.Valid || !f.MaxLong.Valid {
+			return fmt.Errorf("box filter needs min and max lat and long")
+		}
+
+		if !isLat(f.MinLat.Float64) || !isLat(f.MaxLat.Float64) || !isLong(f.MinLong.Float64) || !isLong(f.MaxLong.Float64) {
+			return fmt.Errorf("box is out of range")
+		}
+
+		if f.MinLat.Float64 > f.MaxLat.Float64 || f.MinLong.Float64 > f.MaxLong.Float64 {
+			return fmt.Errorf("box min is greater than its max")
+		}
+	}
+
+	return nil
+}
+
+func isLat(v float64) bool {
+	return v >= -90 && v <= 90
+}
+
+func isLong(v float64) bool {
+	return v >= -180 && v <= 180
+}
+
+// getGeoQuery returns query of rows whose location in the lat and long
+// columns passes f. Both the box and the box around the near point are
+// matched against the gist index on point(long, lat) before the exact
+// distance is checked.
+func getGeoQuery(latColumn, longColumn string, f GeoFilter) []qm.QueryMod {
+	var res []qm.QueryMod
+	point := "point(" + longColumn + ", " + latColumn + ")"
+	if f.HasBox() {
+		res = append(res, qm.Where(point+" <@ box(point(?, ?), point(?, ?))", f.MinLong.Float64, f.MinLat.Float64, f.MaxLong.Float64, f.MaxLat.Float64))
+	}
+
+	if f.HasNear() {
+		if minLong, minLat, maxLong, maxLat, ok := getNearBox(f.NearLat.Float64, f.NearLong.Float64, f.RadiusKM.Float64); ok {
+			res = append(res, qm.Where(point+" <@ box(point(?, ?), point(?, ?))", minLong, minLat, maxLong, maxLat))
+		}
+
+		distance, args := getDistanceQuery(latColumn, longColumn, f.NearLat.Float64, f.NearLong.Float64)
+		res = append(res, qm.Where(distance+"<=?", append(args, f.RadiusKM.Float64)...))
+	}
+
+	return res
+}
+
+// getNearBox returns the box around the circle of radius km around lat and
+// long, it is not ok when the box would wrap around a pole or the
+// antimeridian.
+func getNearBox(lat, long, radius float64) (minLong, minLat, maxLong, maxLat float64, ok bool) {
+	deltaLat := radius / kmPerDegree
+	minLat, maxLat = lat-deltaLat, lat+deltaLat
+	if minLat < -90 || maxLat > 90 {
+		return 0, 0, 0, 0, false
+	}
+
+	deltaLong := radius / (kmPerDegree * math.Cos(math.Max(math.Abs(minLat), math.Abs(maxLat))*math.Pi/180))
+	minLong, maxLong = long-deltaLong, long+deltaLong
+	if minLong < -180 || maxLong > 180 {
+		return 0, 0, 0, 0, false
+	}
+
+	return minLong, minLat, maxLong, maxLat, true
+}
+
+// getDistanceQuery returns the haversine distance in km between the location
+// in the lat and long columns and the point at lat and long.
+func getDistanceQuery(latColumn, longColumn string, lat, long float64) (string, []interface{}) {
+	query := fmt.Sprintf("(%v * 2 * asin(sqrt(least(1, power(sin(radians(%s - ?) / 2), 2) + cos(radians(?)) * cos(radians(%s)) * power(sin(radians(%s - ?) / 2), 2)))))", EarthRadius, latColumn, latColumn, longColumn)
+	return query, []interface{}{lat, lat, long}
+}
+
+// GetDistance returns the haversine distance in km between two points, it is
+// the in memory counterpart of getDistanceQuery.
+func GetDistance(lat1, long1, lat2, long2 float64) float64 {
+	sinLat := math.Sin((lat1 - lat2) * math.Pi / 180 / 2)
+	sinLong := math.Sin((long1 - long2) * math.Pi / 180 / 2)
+	h := sinLat*sinLat + math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*sinLong*sinLong
+	return EarthRadius * 2 * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// Match reports whether the location at lat and long passes f, it is the in
+// memory counterpart of getGeoQuery.
+func (f GeoFilter) Match(lat, long float64) bool {
+	if f.HasBox() && (lat < f.MinLat.Float64 || lat > f.MaxLat.Float64 || long < f.MinLong.Float64 || long > f.MaxLong.Float64) {
+		return false
+	}
+
+	if f.HasNear() && GetDistance(lat, long, f.NearLat.Float64, f.NearLong.Float64) > f.RadiusKM.Float64 {
+		return false
+	}
+
+	return true
+}
+
+// getSortColumns returns the columns orders may be sorted by, which include
+// the distance to the point of a near filter of g.
+func (g *GetOrdersByParam) getSortColumns() map[string]bool {
+	pickup, dropoff := g.GetPickupFilter(), g.GetDropoffFilter()
+	if !pickup.HasNear() && !dropoff.HasNear() {
+		return OrderSortColumns
+	}
+
+	columns := make(map[string]bool, len(OrderSortColumns)+2)
+	for column := range OrderSortColumns {
+		columns[column] = true
+	}
+	columns[PickupDistanceSort] = pickup.HasNear()
+	columns[DropoffDistanceSort] = dropoff.HasNear()
+	return columns
+}
+
+// getSortQuery returns the order by query of g, which is left unsorted when
+// it fails ParseSort so no client input ever reaches the query.
+func (g *GetOrdersByParam) getSortQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	sorts, err := ParseSort(g.OrderBy.String, g.getSortColumns())
+	if err != nil {
+		return res
+	}
+
+	for _, sort := range sorts {
+		var (
+			distance string
+			args     []interface{}
+		)
+		switch sort.Column {
+		case PickupDistanceSort:
+			distance, args = getDistanceQuery("pickup_lat", "pickup_long", g.PickupNearLat.Float64, g.PickupNearLong.Float64)
+		case DropoffDistanceSort:
+			distance, args = getDistanceQuery("dropoff_lat", "dropoff_long", g.DropoffNearLat.Float64, g.DropoffNearLong.Float64)
+		default:
+			res = append(res, qm.OrderBy(sort.String()))
+			continue
+		}
+
+		sort.Column = distance
+		res = append(res, qm.OrderBy(sort.String(), args...))
+	}
+
+	return res
+}
@@ -0,0 +1,96 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetDistance(t *testing.T) {
+	Convey("test get distance", t, func() {
+		So(model.GetDistance(-6.2, 106.8, -6.2, 106.8), ShouldEqual, 0)
+		// one degree of latitude
+		So(model.GetDistance(0, 0, 1, 0), ShouldAlmostEqual, 111.19, 0.01)
+		// jakarta to bandung
+		So(model.GetDistance(-6.2, 106.8, -6.9, 107.6), ShouldAlmostEqual, 117.9, 0.5)
+	})
+}
+
+func TestGetOrderByParamGeoValidate(t *testing.T) {
+	Convey("test validate geo filters", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			param    model.GetOrderByParam
+		}{
+			{testType: "P", testDesc: "no geo filter", param: model.GetOrderByParam{}},
+			{testType: "P", testDesc: "pickup near point", param: model.GetOrderByParam{PickupNearLat: null.Float64From(-6.2), PickupNearLong: null.Float64From(106.8), PickupRadiusKM: null.Float64From(5)}},
+			{testType: "P", testDesc: "dropoff box", param: model.GetOrderByParam{DropoffMinLat: null.Float64From(-7), DropoffMaxLat: null.Float64From(-6), DropoffMinLong: null.Float64From(106), DropoffMaxLong: null.Float64From(108)}},
+			{testType: "N", testDesc: "near point without radius", param: model.GetOrderByParam{PickupNearLat: null.Float64From(-6.2), PickupNearLong: null.Float64From(106.8)}},
+			{testType: "N", testDesc: "zero radius", param: model.GetOrderByParam{PickupNearLat: null.Float64From(-6.2), PickupNearLong: null.Float64From(106.8), PickupRadiusKM: null.Float64From(0)}},
+			{testType: "N", testDesc: "near point out of range", param: model.GetOrderByParam{DropoffNearLat: null.Float64From(91), DropoffNearLong: null.Float64From(106.8), DropoffRadiusKM: null.Float64From(5)}},
+			{testType: "N", testDesc: "box without a side", param: model.GetOrderByParam{PickupMinLat: null.Float64From(-7), PickupMaxLat: null.Float64From(-6), PickupMinLong: null.Float64From(106)}},
+			{testType: "N", testDesc: "box min greater than max", param: model.GetOrderByParam{PickupMinLat: null.Float64From(-6), PickupMaxLat: null.Float64From(-7), PickupMinLong: null.Float64From(106), PickupMaxLong: null.Float64From(108)}},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := test.param.Validate()
+				if test.testType == "N" {
+					So(errormsg.GetErrorCode(err), ShouldEqual, svcerr.CodeInvalidGeoFilter)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestGeoFilterMatch(t *testing.T) {
+	Convey("test match location against geo filter", t, func() {
+		near := model.GeoFilter{NearLat: null.Float64From(-6.2), NearLong: null.Float64From(106.8), RadiusKM: null.Float64From(5)}
+		So(near.Match(-6.21, 106.81), ShouldBeTrue)
+		So(near.Match(-6.3, 106.8), ShouldBeFalse)
+
+		box := model.GeoFilter{MinLat: null.Float64From(-7), MaxLat: null.Float64From(-6), MinLong: null.Float64From(106), MaxLong: null.Float64From(107)}
+		So(box.Match(-6.5, 106.5), ShouldBeTrue)
+		So(box.Match(-6.5, 107.5), ShouldBeFalse)
+
+		So(model.GeoFilter{}.Match(0, 0), ShouldBeTrue)
+	})
+}
+
+func TestGetOrdersByParamGeoQuery(t *testing.T) {
+	Convey("test query orders near a point sorted by distance", t, func() {
+		param := model.GetOrdersByParam{
+			GetOrderByParam: model.GetOrderByParam{
+				PickupNearLat:  null.Float64From(-6.2),
+				PickupNearLong: null.Float64From(106.8),
+				PickupRadiusKM: null.Float64From(5),
+			},
+			OrderBy: null.StringFrom("pickup_distance,-id"),
+		}
+		So(param.Validate(), ShouldBeNil)
+
+		query, args := queries.BuildQuery(psqlmodel.Orders(param.GetQuery()...).Query)
+		So(query, ShouldContainSubstring, "(point(pickup_long, pickup_lat) <@ box(point($1, $2), point($3, $4)))")
+		So(query, ShouldContainSubstring, "power(sin(radians(pickup_lat - $5) / 2), 2)")
+		So(query, ShouldContainSubstring, "<=$8)")
+		So(query, ShouldContainSubstring, "ORDER BY (6371 * 2 * asin(")
+		So(query, ShouldEndWith, "ASC, id DESC;")
+		So(args[7], ShouldEqual, 5)
+		So(args[8:], ShouldResemble, []interface{}{-6.2, -6.2, 106.8})
+
+		Convey("test distance sort needs near filter", func() {
+			param.OrderBy = null.StringFrom("dropoff_distance")
+			So(errormsg.GetErrorCode(param.Validate()), ShouldEqual, svcerr.CodeInvalidSort)
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetLateQuery returns query of picked up orders whose dropoff date has passed
+// at time now.
+func GetLateQuery(now time.Time) []qm.QueryMod {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return []qm.QueryMod{
+		qm.Where("status=?", OrderStatusPickedUp),
+		qm.Where("dropoff_date<?", today),
+	}
+}
+
+// getOverdueQuery returns query of orders which are flagged as overdue and not
+// returned yet, or the opposite when isOverdue is false.
+func getOverdueQuery(isOverdue bool) qm.QueryMod {
+	if isOverdue {
+		return qm.Where("(status=? AND overdue_at IS NOT NULL)", OrderStatusPickedUp)
+	}
+
+	return qm.Where("(status<>? OR overdue_at IS NULL)", OrderStatusPickedUp)
+}
+
+// CalculateLateFee returns the number of days an order is returned late at
+// time now and the fee for those days. The dropoff date is still a rental day,
+// so lateness starts on the day after it and each late day is charged with
+// day rate times multiplier.
+func CalculateLateFee(now, dropoffDate time.Time, dayRate, multiplier float64) (int64, float64) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	dropoff := time.Date(dropoffDate.Year(), dropoffDate.Month(), dropoffDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	lateDays := int64(math.Round(today.Sub(dropoff).Hours() / 24))
+	if lateDays < 1 {
+		return 0, 0
+	}
+
+	return lateDays, math.Round(float64(lateDays)*dayRate*multiplier*100) / 100
+}
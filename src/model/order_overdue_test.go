@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCalculateLateFee(t *testing.T) {
+	dropoffDate := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+	Convey("test calculate late fee", t, func() {
+		tests := []struct {
+			testType     string
+			testDesc     string
+			now          time.Time
+			wantLateDays int64
+			wantFee      float64
+		}{
+			{testType: "P", testDesc: "return before dropoff date is not late", now: dropoffDate.Add(-12 * time.Hour), wantLateDays: 0, wantFee: 0},
+			{testType: "P", testDesc: "return on dropoff date is not late", now: dropoffDate.Add(20 * time.Hour), wantLateDays: 0, wantFee: 0},
+			{testType: "P", testDesc: "return the day after dropoff date is one day late", now: dropoffDate.Add(26 * time.Hour), wantLateDays: 1, wantFee: 150000},
+			{testType: "P", testDesc: "return three days after dropoff date is three days late", now: dropoffDate.AddDate(0, 0, 3), wantLateDays: 3, wantFee: 450000},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				lateDays, fee := model.CalculateLateFee(test.now, dropoffDate, 100000, 1.5)
+				So(lateDays, ShouldEqual, test.wantLateDays)
+				So(fee, ShouldEqual, test.wantFee)
+			})
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusConfirmed = "confirmed"
+	OrderStatusPickedUp  = "picked_up"
+	OrderStatusReturned  = "returned"
+	OrderStatusCompleted = "completed"
+	OrderStatusCancelled = "cancelled"
+)
+
+// orderStatusTransition lists the statuses an order may move to from each status.
+var orderStatusTransition = map[string][]string{
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusPickedUp, OrderStatusCancelled},
+	OrderStatusPickedUp:  {OrderStatusReturned},
+	OrderStatusReturned:  {OrderStatusCompleted},
+}
+
+// ValidateStatusTransition returns error when an order in status from is not
+// allowed to move to status to.
+func ValidateStatusTransition(from, to string) error {
+	switch from {
+	case OrderStatusCancelled:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCancelled, nil, "order is cancelled")
+	case OrderStatusCompleted:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCompleted, nil, "order is completed")
+	}
+
+	for _, status := range orderStatusTransition[from] {
+		if status == to {
+			return nil
+		}
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCInvalidTransition, nil, "invalid status transition from "+from+" to "+to)
+}
+
+// ValidateOrderEditable returns error when order details can no longer be
+// changed, that is once the car has been picked up or the order is closed.
+func ValidateOrderEditable(status string) error {
+	switch status {
+	case OrderStatusPending, OrderStatusConfirmed:
+		return nil
+	case OrderStatusCancelled:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCancelled, nil, "order is cancelled")
+	case OrderStatusCompleted:
+		return errormsg.WrapErr(svcerr.OrderSVCOrderCompleted, nil, "order is completed")
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCOrderNotEditable, nil, "order is not editable")
+}
+
+type OrderStatusHistory struct {
+	ID         int64       `json:"id"`
+	OrderID    int64       `json:"order_id"`
+	FromStatus null.String `json:"from_status" swaggertype:"string"`
+	ToStatus   string      `json:"to_status"`
+	CreatedBy  int64       `json:"created_by"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+func TransformOrderStatusHistoryReply(v *psqlmodel.OrderStatusHistorySlice) *grpcmodel.GetOrderStatusHistoryReply {
+	var data []*grpcmodel.OrderStatusHistoryReply
+	for _, val := range *v {
+		data = append(data, &grpcmodel.OrderStatusHistoryReply{
+			Id:         int64(val.ID),
+			OrderId:    int64(val.OrderID),
+			FromStatus: val.FromStatus.Ptr(),
+			ToStatus:   val.ToStatus,
+			CreatedBy:  int64(val.CreatedBy),
+			CreatedAt:  val.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &grpcmodel.GetOrderStatusHistoryReply{
+		Data: data,
+	}
+}
+
+func TransformOrderStatusHistoryReplyToHistory(ctx context.Context, v *grpcmodel.GetOrderStatusHistoryReply, log logger.Logger) []OrderStatusHistory {
+	var res []OrderStatusHistory
+	for _, val := range v.Data {
+		createdAt, err := time.Parse(time.RFC3339, val.CreatedAt)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+
+		res = append(res, OrderStatusHistory{
+			ID:         val.Id,
+			OrderID:    val.OrderId,
+			FromStatus: null.StringFromPtr(val.FromStatus),
+			ToStatus:   val.ToStatus,
+			CreatedBy:  val.CreatedBy,
+			CreatedAt:  createdAt,
+		})
+	}
+
+	return res
+}
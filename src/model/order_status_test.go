@@ -0,0 +1,46 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateStatusTransition(t *testing.T) {
+	Convey("test validate status transition", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			from     string
+			to       string
+			wantCode int64
+		}{
+			{testType: "P", testDesc: "pending to confirmed", from: model.OrderStatusPending, to: model.OrderStatusConfirmed},
+			{testType: "P", testDesc: "pending to cancelled", from: model.OrderStatusPending, to: model.OrderStatusCancelled},
+			{testType: "P", testDesc: "confirmed to picked up", from: model.OrderStatusConfirmed, to: model.OrderStatusPickedUp},
+			{testType: "P", testDesc: "confirmed to cancelled", from: model.OrderStatusConfirmed, to: model.OrderStatusCancelled},
+			{testType: "P", testDesc: "picked up to returned", from: model.OrderStatusPickedUp, to: model.OrderStatusReturned},
+			{testType: "P", testDesc: "returned to completed", from: model.OrderStatusReturned, to: model.OrderStatusCompleted},
+			{testType: "N", testDesc: "pending to picked up", from: model.OrderStatusPending, to: model.OrderStatusPickedUp, wantCode: svcerr.CodeInvalidStatusTransition},
+			{testType: "N", testDesc: "picked up to cancelled", from: model.OrderStatusPickedUp, to: model.OrderStatusCancelled, wantCode: svcerr.CodeInvalidStatusTransition},
+			{testType: "N", testDesc: "cancelled to confirmed", from: model.OrderStatusCancelled, to: model.OrderStatusConfirmed, wantCode: svcerr.CodeOrderCancelled},
+			{testType: "N", testDesc: "completed to cancelled", from: model.OrderStatusCompleted, to: model.OrderStatusCancelled, wantCode: svcerr.CodeOrderCompleted},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := model.ValidateStatusTransition(test.from, test.to)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOrdersByParamQuery(t *testing.T) {
+	Convey("test query orders by range and in filters", t, func() {
+		from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		param := model.GetOrdersByParam{
+			GetOrderByParam: model.GetOrderByParam{
+				PickupDateFrom: null.TimeFrom(from),
+				PickupDateTo:   null.TimeFrom(to),
+				CreatedAtTo:    null.TimeFrom(to),
+				CarIDIn:        []int64{1, 2},
+				StatusIn:       []string{model.OrderStatusPending},
+			},
+		}
+
+		query, args := queries.BuildQuery(psqlmodel.Orders(param.GetQuery()...).Query)
+		So(query, ShouldContainSubstring, `(pickup_date>=$1) AND (pickup_date<=$2) AND (created_at<=$3) AND ("car_id" IN ($4,$5)) AND ("status" IN ($6))`)
+		So(args, ShouldResemble, []interface{}{from, to, to, int64(1), int64(2), model.OrderStatusPending})
+	})
+}
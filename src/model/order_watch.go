@@ -0,0 +1,220 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/common"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// LastEventIDHeader is sent by a reconnecting event source with the id of
+	// the last event it received.
+	LastEventIDHeader = "Last-Event-ID"
+
+	DefaultStreamHeartbeat time.Duration = 15 * time.Second
+)
+
+// WatchOrdersByParam filters the order events streamed to a client.
+type WatchOrdersByParam struct {
+	CarID       null.Int64 `schema:"car_id" json:"car_id"`
+	StartDate   null.Time  `schema:"start_date" json:"start_date"`
+	EndDate     null.Time  `schema:"end_date" json:"end_date"`
+	LastEventID null.Int64 `schema:"-" json:"-"`
+}
+
+// Validate returns an error when the filters of w can never match an order,
+// so a stream is refused before it starts.
+func (w *WatchOrdersByParam) Validate() error {
+	if w.CarID.Valid && w.CarID.Int64 <= 0 {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidCarID, nil, "invalid car id")
+	}
+
+	if w.StartDate.Valid && w.EndDate.Valid && w.EndDate.Time.Before(w.StartDate.Time) {
+		return errormsg.WrapErr(svcerr.OrderSVCBadRequest, nil, "end date is before start date")
+	}
+
+	return nil
+}
+
+func (w *WatchOrdersByParam) FillGrpcClient() *grpcmodel.GetOrderByParamRequest {
+	res := &grpcmodel.GetOrderByParamRequest{
+		CarId:       w.CarID.Ptr(),
+		LastEventId: w.LastEventID.Ptr(),
+	}
+
+	if w.StartDate.Valid {
+		startDate := w.StartDate.Time.Format(time.RFC3339)
+		res.StartDate = &startDate
+	}
+
+	if w.EndDate.Valid {
+		endDate := w.EndDate.Time.Format(time.RFC3339)
+		res.EndDate = &endDate
+	}
+
+	return res
+}
+
+type OrderEvent struct {
+	ID        int64     `json:"id"`
+	EventType string    `json:"event_type"`
+	Data      Order     `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Match reports whether order passes the filters of g, it is the in memory
+// counterpart of GetQuery for orders that are not read from the database.
+func (g *GetOrderByParam) Match(order *psqlmodel.Order) bool {
+	if g.ID.Valid && int64(order.ID) != g.ID.Int64 {
+		return false
+	}
+
+	if g.CarID.Valid && int64(order.CarID) != g.CarID.Int64 {
+		return false
+	}
+
+	if g.OrderDate.Valid && !order.OrderDate.Equal(g.OrderDate.Time) {
+		return false
+	}
+
+	if g.PickupDate.Valid && !order.PickupDate.Equal(g.PickupDate.Time) {
+		return false
+	}
+
+	if g.DropoffDate.Valid && !order.DropoffDate.Equal(g.DropoffDate.Time) {
+		return false
+	}
+
+	if g.PickupLocation.Valid && order.PickupLocation != g.PickupLocation.String {
+		return false
+	}
+
+	if g.PickupLat.Valid && order.PickupLat != g.PickupLat.Float64 {
+		return false
+	}
+
+	if g.PickupLong.Valid && order.PickupLong != g.PickupLong.Float64 {
+		return false
+	}
+
+	if g.DropoffLocation.Valid && order.DropoffLocation != g.DropoffLocation.String {
+		return false
+	}
+
+	if g.DropoffLat.Valid && order.DropoffLat != g.DropoffLat.Float64 {
+		return false
+	}
+
+	if g.DropoffLong.Valid && order.DropoffLong != g.DropoffLong.Float64 {
+		return false
+	}
+
+	if g.IsOverdue.Valid && isOrderOverdue(order) != g.IsOverdue.Bool {
+		return false
+	}
+
+	if g.EndDate.Valid && order.PickupDate.After(g.EndDate.Time) {
+		return false
+	}
+
+	if g.StartDate.Valid && order.DropoffDate.Before(g.StartDate.Time) {
+		return false
+	}
+
+	if !inRange(order.OrderDate, g.OrderDateFrom, g.OrderDateTo) ||
+		!inRange(order.PickupDate, g.PickupDateFrom, g.PickupDateTo) ||
+		!inRange(order.DropoffDate, g.DropoffDateFrom, g.DropoffDateTo) ||
+		!inRange(order.CreatedAt, g.CreatedAtFrom, g.CreatedAtTo) {
+		return false
+	}
+
+	if len(g.CarIDIn) > 0 && !containsInt64(g.CarIDIn, int64(order.CarID)) {
+		return false
+	}
+
+	if len(g.StatusIn) > 0 && !common.FindStrInSlice(order.Status, g.StatusIn) {
+		return false
+	}
+
+	if !g.GetPickupFilter().Match(order.PickupLat, order.PickupLong) ||
+		!g.GetDropoffFilter().Match(order.DropoffLat, order.DropoffLong) {
+		return false
+	}
+
+	if g.CreatedBy.Valid && int64(order.CreatedBy) != g.CreatedBy.Int64 {
+		return false
+	}
+
+	return true
+}
+
+// inRange is the in memory counterpart of getRangeQuery.
+func inRange(t time.Time, from, to null.Time) bool {
+	if from.Valid && t.Before(from.Time) {
+		return false
+	}
+
+	return !to.Valid || !t.After(to.Time)
+}
+
+func containsInt64(values []int64, v int64) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOrderOverdue is the in memory counterpart of getOverdueQuery.
+func isOrderOverdue(order *psqlmodel.Order) bool {
+	return order.Status == OrderStatusPickedUp && order.OverdueAt.Valid
+}
+
+// IsOrderEvent reports whether event is recorded for an order.
+func IsOrderEvent(event OutboxEvent) bool {
+	return event.AggregateType == OutboxAggregateOrder
+}
+
+// GetOutboxEventOrder returns the order stored as payload of an order event.
+func GetOutboxEventOrder(event OutboxEvent) (*psqlmodel.Order, error) {
+	var order psqlmodel.Order
+	err := json.Unmarshal(event.Payload, &order)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal order event payload")
+	}
+
+	return &order, nil
+}
+
+func TransformOrderEventReply(event OutboxEvent, order *psqlmodel.Order) *grpcmodel.OrderEventReply {
+	return &grpcmodel.OrderEventReply{
+		Id:        event.ID,
+		EventType: event.EventType,
+		Data:      TransformSingleOrderReply(order),
+		CreatedAt: event.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func TransformOrderEventReplyToOrderEvent(ctx context.Context, v *grpcmodel.OrderEventReply, log logger.Logger) OrderEvent {
+	createdAt, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	return OrderEvent{
+		ID:        v.Id,
+		EventType: v.EventType,
+		Data:      TransformSingleOrderReplyToOrder(ctx, v.Data, log),
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,149 @@
+package model_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestGetOrderByParamMatch(t *testing.T) {
+	pickupDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	order := &psqlmodel.Order{
+		ID:              3,
+		CarID:           7,
+		CreatedBy:       5,
+		PickupDate:      pickupDate,
+		DropoffDate:     pickupDate.AddDate(0, 0, 3),
+		PickupLocation:  "Jakarta",
+		DropoffLocation: "Bandung",
+		DropoffLong:     107.6,
+		Status:          model.OrderStatusPickedUp,
+		OverdueAt:       null.TimeFrom(pickupDate.AddDate(0, 0, 4)),
+		CreatedAt:       pickupDate.AddDate(0, 0, -1),
+	}
+
+	Convey("test match order against param", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			param    model.GetOrderByParam
+			want     bool
+		}{
+			{testType: "P", testDesc: "no filter", param: model.GetOrderByParam{}, want: true},
+			{testType: "P", testDesc: "matching car and pickup date", param: model.GetOrderByParam{CarID: null.Int64From(7), PickupDate: null.TimeFrom(pickupDate)}, want: true},
+			{testType: "P", testDesc: "matching dropoff long", param: model.GetOrderByParam{DropoffLong: null.Float64From(107.6)}, want: true},
+			{testType: "P", testDesc: "matching overdue", param: model.GetOrderByParam{IsOverdue: null.BoolFrom(true)}, want: true},
+			{testType: "P", testDesc: "matching creator", param: model.GetOrderByParam{CreatedBy: null.Int64From(5)}, want: true},
+			{testType: "N", testDesc: "other creator", param: model.GetOrderByParam{CreatedBy: null.Int64From(6)}, want: false},
+			{testType: "N", testDesc: "other car", param: model.GetOrderByParam{CarID: null.Int64From(8)}, want: false},
+			{testType: "N", testDesc: "other pickup location", param: model.GetOrderByParam{PickupLocation: null.StringFrom("Bogor")}, want: false},
+			{testType: "N", testDesc: "other dropoff date", param: model.GetOrderByParam{DropoffDate: null.TimeFrom(pickupDate)}, want: false},
+			{testType: "N", testDesc: "not overdue", param: model.GetOrderByParam{IsOverdue: null.BoolFrom(false)}, want: false},
+			{testType: "P", testDesc: "window overlapping rental", param: model.GetOrderByParam{StartDate: null.TimeFrom(pickupDate.AddDate(0, 0, 3)), EndDate: null.TimeFrom(pickupDate.AddDate(0, 0, 10))}, want: true},
+			{testType: "P", testDesc: "window open at the start", param: model.GetOrderByParam{EndDate: null.TimeFrom(pickupDate)}, want: true},
+			{testType: "N", testDesc: "window after rental", param: model.GetOrderByParam{StartDate: null.TimeFrom(pickupDate.AddDate(0, 0, 4))}, want: false},
+			{testType: "N", testDesc: "window before rental", param: model.GetOrderByParam{StartDate: null.TimeFrom(pickupDate.AddDate(0, 0, -5)), EndDate: null.TimeFrom(pickupDate.AddDate(0, 0, -1))}, want: false},
+			{testType: "P", testDesc: "pickup date within range", param: model.GetOrderByParam{PickupDateFrom: null.TimeFrom(pickupDate), PickupDateTo: null.TimeFrom(pickupDate)}, want: true},
+			{testType: "P", testDesc: "dropoff date range open at the end", param: model.GetOrderByParam{DropoffDateFrom: null.TimeFrom(pickupDate)}, want: true},
+			{testType: "N", testDesc: "pickup date before range", param: model.GetOrderByParam{PickupDateFrom: null.TimeFrom(pickupDate.AddDate(0, 0, 1))}, want: false},
+			{testType: "N", testDesc: "created after range", param: model.GetOrderByParam{CreatedAtTo: null.TimeFrom(pickupDate.AddDate(0, 0, -2))}, want: false},
+			{testType: "P", testDesc: "car among cars", param: model.GetOrderByParam{CarIDIn: []int64{6, 7}}, want: true},
+			{testType: "N", testDesc: "car not among cars", param: model.GetOrderByParam{CarIDIn: []int64{6, 8}}, want: false},
+			{testType: "P", testDesc: "status among statuses", param: model.GetOrderByParam{StatusIn: []string{model.OrderStatusConfirmed, model.OrderStatusPickedUp}}, want: true},
+			{testType: "N", testDesc: "status not among statuses", param: model.GetOrderByParam{StatusIn: []string{model.OrderStatusPending}}, want: false},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				So(test.param.Match(order), ShouldEqual, test.want)
+			})
+		}
+	})
+}
+
+func TestGetOutboxEventOrder(t *testing.T) {
+	Convey("test get order of outbox event", t, func() {
+		pickupDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+		payload, _ := json.Marshal(psqlmodel.Order{ID: 3, CarID: 7, PickupDate: pickupDate})
+
+		order, err := model.GetOutboxEventOrder(model.OutboxEvent{Payload: payload})
+		So(err, ShouldBeNil)
+		So(order.ID, ShouldEqual, 3)
+		So(order.PickupDate.Equal(pickupDate), ShouldBeTrue)
+
+		_, err = model.GetOutboxEventOrder(model.OutboxEvent{Payload: []byte(`[]`)})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestWatchOrdersByParamFillGrpcClient(t *testing.T) {
+	Convey("test fill grpc client of watch orders param", t, func() {
+		startDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+		param := model.WatchOrdersByParam{
+			CarID:       null.Int64From(7),
+			StartDate:   null.TimeFrom(startDate),
+			LastEventID: null.Int64From(42),
+		}
+
+		res := param.FillGrpcClient()
+		So(res.GetCarId(), ShouldEqual, 7)
+		So(res.GetStartDate(), ShouldEqual, "2024-02-02T00:00:00Z")
+		So(res.EndDate, ShouldBeNil)
+		So(res.GetLastEventId(), ShouldEqual, 42)
+	})
+}
+
+func TestWatchOrdersByParamValidate(t *testing.T) {
+	startDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	Convey("test validate watch orders param", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			param    model.WatchOrdersByParam
+		}{
+			{
+				testType: "P",
+				testDesc: "test no filter",
+				param:    model.WatchOrdersByParam{},
+			},
+			{
+				testType: "P",
+				testDesc: "test car and date range",
+				param: model.WatchOrdersByParam{
+					CarID:     null.Int64From(7),
+					StartDate: null.TimeFrom(startDate),
+					EndDate:   null.TimeFrom(startDate.AddDate(0, 0, 1)),
+				},
+			},
+			{
+				testType: "N",
+				testDesc: "test invalid car id",
+				param:    model.WatchOrdersByParam{CarID: null.Int64From(0)},
+			},
+			{
+				testType: "N",
+				testDesc: "test end date before start date",
+				param: model.WatchOrdersByParam{
+					StartDate: null.TimeFrom(startDate),
+					EndDate:   null.TimeFrom(startDate.AddDate(0, 0, -1)),
+				},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := test.param.Validate()
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
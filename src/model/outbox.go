@@ -0,0 +1,106 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+const (
+	OutboxAggregateOrder = "order"
+	OutboxAggregateCar   = "car"
+
+	OrderEventCreated       = "order.created"
+	OrderEventUpdated       = "order.updated"
+	OrderEventStatusChanged = "order.status_changed"
+	OrderEventCancelled     = "order.cancelled"
+	OrderEventDeleted       = "order.deleted"
+	CarEventCreated         = "car.created"
+	CarEventUpdated         = "car.updated"
+	CarEventDeleted         = "car.deleted"
+
+	OutboxStatusPending   = "pending"
+	OutboxStatusPublished = "published"
+	OutboxStatusFailed    = "failed"
+
+	DefaultOutboxBatchSize    int           = 100
+	DefaultOutboxMaxAttempts  int           = 10
+	DefaultOutboxRetryBackoff time.Duration = 5 * time.Second
+	// MaxOutboxRetryBackoff caps the delay between two deliveries of an event.
+	MaxOutboxRetryBackoff time.Duration = time.Hour
+	// DefaultOutboxClaimTimeout is how long a relay keeps the events it
+	// claimed from the other relays.
+	DefaultOutboxClaimTimeout time.Duration = time.Minute
+	// DefaultOutboxReplayLimit caps the events replayed to a resumed watcher.
+	DefaultOutboxReplayLimit int = 1000
+)
+
+type OutboxEvent struct {
+	ID            int64           `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   int64           `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// NewOutbox returns a pending outbox row for an event on the given aggregate,
+// payload is stored as json.
+func NewOutbox(aggregateType string, aggregateID int, eventType string, payload interface{}) (*psqlmodel.Outbox, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error marshal outbox payload")
+	}
+
+	return &psqlmodel.Outbox{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+		Status:        OutboxStatusPending,
+	}, nil
+}
+
+// GetOrderStatusEvent returns the event recorded when an order moves to
+// status to.
+func GetOrderStatusEvent(to string) string {
+	if to == OrderStatusCancelled {
+		return OrderEventCancelled
+	}
+
+	return OrderEventStatusChanged
+}
+
+// GetOutboxRetryAt returns when an event that failed its attempts-th delivery
+// is retried, the backoff doubles with every attempt up to
+// MaxOutboxRetryBackoff.
+func GetOutboxRetryAt(now time.Time, attempts int, backoff time.Duration) time.Time {
+	return getRetryAt(now, attempts, backoff, MaxOutboxRetryBackoff)
+}
+
+func getRetryAt(now time.Time, attempts int, backoff, maxDelay time.Duration) time.Time {
+	delay := backoff
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return now.Add(delay)
+}
+
+func TransformOutboxToOutboxEvent(v *psqlmodel.Outbox) OutboxEvent {
+	return OutboxEvent{
+		ID:            int64(v.ID),
+		AggregateType: v.AggregateType,
+		AggregateID:   int64(v.AggregateID),
+		EventType:     v.EventType,
+		Payload:       json.RawMessage(v.Payload),
+		CreatedAt:     v.CreatedAt,
+	}
+}
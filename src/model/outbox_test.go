@@ -0,0 +1,39 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOutboxRetryAt(t *testing.T) {
+	now := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	Convey("test get outbox retry at", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			attempts int
+			want     time.Time
+		}{
+			{testType: "P", testDesc: "first failure waits the backoff", attempts: 1, want: now.Add(5 * time.Second)},
+			{testType: "P", testDesc: "backoff doubles with every attempt", attempts: 3, want: now.Add(20 * time.Second)},
+			{testType: "P", testDesc: "backoff is capped", attempts: 30, want: now.Add(model.MaxOutboxRetryBackoff)},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				So(model.GetOutboxRetryAt(now, test.attempts, 5*time.Second), ShouldEqual, test.want)
+			})
+		}
+	})
+}
+
+func TestGetOrderStatusEvent(t *testing.T) {
+	Convey("test get order status event", t, func() {
+		So(model.GetOrderStatusEvent(model.OrderStatusCancelled), ShouldEqual, model.OrderEventCancelled)
+		So(model.GetOrderStatusEvent(model.OrderStatusConfirmed), ShouldEqual, model.OrderEventStatusChanged)
+	})
+}
@@ -0,0 +1,20 @@
+package model
+
+// Actions a role may be permitted to take, an own action is limited to the
+// resources created by the principal and an any action is not.
+const (
+	PermissionCarRead         = "car:read"
+	PermissionCarWrite        = "car:write"
+	PermissionOrderReadOwn    = "order:read:own"
+	PermissionOrderReadAny    = "order:read:any"
+	PermissionOrderWriteOwn   = "order:write:own"
+	PermissionOrderWriteAny   = "order:write:any"
+	PermissionOrderCancel     = "order:cancel"
+	PermissionOrderTransition = "order:transition"
+	PermissionOrderDelete     = "order:delete"
+	PermissionWebhookManage   = "webhook:manage"
+	PermissionAuditRead       = "audit:read"
+
+	// PermissionsKey is the gin key of the actions granted to a rest request.
+	PermissionsKey = "permissions"
+)
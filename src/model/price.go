@@ -0,0 +1,138 @@
+package model
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+)
+
+// MonthBlockDays is the number of rental days charged as one month rate.
+const MonthBlockDays = 30
+
+type OrderPrice struct {
+	TotalDays     int64   `json:"total_days"`
+	MonthBlocks   int64   `json:"month_blocks"`
+	RemainderDays int64   `json:"remainder_days"`
+	DayRate       float64 `json:"day_rate"`
+	MonthRate     float64 `json:"month_rate"`
+	Subtotal      float64 `json:"subtotal"`
+}
+
+// CalculatePrice computes the price of renting a car from pickup date until
+// dropoff date, both inclusive. Every full 30 days is charged with month rate
+// and the remaining days with day rate, unless the remaining days cost more
+// than one month rate, in which case another month block is applied instead.
+func CalculatePrice(pickupDate, dropoffDate time.Time, dayRate, monthRate float64) OrderPrice {
+	pickup := time.Date(pickupDate.Year(), pickupDate.Month(), pickupDate.Day(), 0, 0, 0, 0, time.UTC)
+	dropoff := time.Date(dropoffDate.Year(), dropoffDate.Month(), dropoffDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	totalDays := int64(math.Round(dropoff.Sub(pickup).Hours()/24)) + 1
+	if totalDays < 1 {
+		totalDays = 1
+	}
+
+	price := OrderPrice{
+		TotalDays:     totalDays,
+		MonthBlocks:   totalDays / MonthBlockDays,
+		RemainderDays: totalDays % MonthBlockDays,
+		DayRate:       dayRate,
+		MonthRate:     monthRate,
+	}
+
+	if float64(price.RemainderDays)*dayRate > monthRate {
+		price.MonthBlocks++
+		price.RemainderDays = 0
+	}
+
+	price.Subtotal = float64(price.MonthBlocks)*monthRate + float64(price.RemainderDays)*dayRate
+	return price
+}
+
+func (p OrderPrice) FillEntity(order *psqlmodel.Order) {
+	order.TotalDays = int(p.TotalDays)
+	order.MonthBlocks = int(p.MonthBlocks)
+	order.RemainderDays = int(p.RemainderDays)
+	order.DayRate = p.DayRate
+	order.MonthRate = p.MonthRate
+	order.Subtotal = p.Subtotal
+}
+
+func TransformPSQLOrderPrice(order *psqlmodel.Order) OrderPrice {
+	return OrderPrice{
+		TotalDays:     int64(order.TotalDays),
+		MonthBlocks:   int64(order.MonthBlocks),
+		RemainderDays: int64(order.RemainderDays),
+		DayRate:       order.DayRate,
+		MonthRate:     order.MonthRate,
+		Subtotal:      order.Subtotal,
+	}
+}
+
+func TransformOrderPriceReply(order *psqlmodel.Order) *grpcmodel.OrderPrice {
+	return &grpcmodel.OrderPrice{
+		TotalDays:     int64(order.TotalDays),
+		MonthBlocks:   int64(order.MonthBlocks),
+		RemainderDays: int64(order.RemainderDays),
+		DayRate:       order.DayRate,
+		MonthRate:     order.MonthRate,
+		Subtotal:      order.Subtotal,
+	}
+}
+
+func TransformOrderPriceReplyToOrderPrice(v *grpcmodel.OrderPrice) OrderPrice {
+	if v == nil {
+		return OrderPrice{}
+	}
+
+	return OrderPrice{
+		TotalDays:     v.TotalDays,
+		MonthBlocks:   v.MonthBlocks,
+		RemainderDays: v.RemainderDays,
+		DayRate:       v.DayRate,
+		MonthRate:     v.MonthRate,
+		Subtotal:      v.Subtotal,
+	}
+}
+
+type Quote struct {
+	CarID       int64      `json:"car_id"`
+	PickupDate  time.Time  `json:"pickup_date"`
+	DropoffDate time.Time  `json:"dropoff_date"`
+	Price       OrderPrice `json:"price"`
+}
+
+func TransformQuoteReply(v *psqlmodel.Order) *grpcmodel.QuoteOrderReply {
+	return &grpcmodel.QuoteOrderReply{
+		CarId:       int64(v.CarID),
+		PickupDate:  v.PickupDate.Format(time.RFC3339),
+		DropoffDate: v.DropoffDate.Format(time.RFC3339),
+		Price:       TransformOrderPriceReply(v),
+	}
+}
+
+func TransformQuoteReplyToQuote(ctx context.Context, v *grpcmodel.QuoteOrderReply, log logger.Logger) Quote {
+	if v == nil {
+		return Quote{}
+	}
+
+	pickupDate, err := time.Parse(time.RFC3339, v.PickupDate)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	dropoffDate, err := time.Parse(time.RFC3339, v.DropoffDate)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	return Quote{
+		CarID:       v.CarId,
+		PickupDate:  pickupDate,
+		DropoffDate: dropoffDate,
+		Price:       TransformOrderPriceReplyToOrderPrice(v.Price),
+	}
+}
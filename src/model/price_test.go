@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	pickupDate := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	Convey("test calculate price", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			args     struct {
+				dropoffDate time.Time
+				dayRate     float64
+				monthRate   float64
+			}
+			want model.OrderPrice
+		}{
+			{
+				testType: "P",
+				testDesc: "same day rental is charged one day",
+				args: struct {
+					dropoffDate time.Time
+					dayRate     float64
+					monthRate   float64
+				}{
+					dropoffDate: pickupDate,
+					dayRate:     100000,
+					monthRate:   2500000,
+				},
+				want: model.OrderPrice{TotalDays: 1, MonthBlocks: 0, RemainderDays: 1, DayRate: 100000, MonthRate: 2500000, Subtotal: 100000},
+			},
+			{
+				testType: "P",
+				testDesc: "rental shorter than a month is charged daily",
+				args: struct {
+					dropoffDate time.Time
+					dayRate     float64
+					monthRate   float64
+				}{
+					dropoffDate: pickupDate.AddDate(0, 0, 4),
+					dayRate:     100000,
+					monthRate:   2500000,
+				},
+				want: model.OrderPrice{TotalDays: 5, MonthBlocks: 0, RemainderDays: 5, DayRate: 100000, MonthRate: 2500000, Subtotal: 500000},
+			},
+			{
+				testType: "P",
+				testDesc: "rental longer than a month is charged month block and daily remainder",
+				args: struct {
+					dropoffDate time.Time
+					dayRate     float64
+					monthRate   float64
+				}{
+					dropoffDate: pickupDate.AddDate(0, 0, 34),
+					dayRate:     100000,
+					monthRate:   2500000,
+				},
+				want: model.OrderPrice{TotalDays: 35, MonthBlocks: 1, RemainderDays: 5, DayRate: 100000, MonthRate: 2500000, Subtotal: 3000000},
+			},
+			{
+				testType: "P",
+				testDesc: "daily remainder more expensive than month rate is charged another month block",
+				args: struct {
+					dropoffDate time.Time
+					dayRate     float64
+					monthRate   float64
+				}{
+					dropoffDate: pickupDate.AddDate(0, 0, 27),
+					dayRate:     100000,
+					monthRate:   2500000,
+				},
+				want: model.OrderPrice{TotalDays: 28, MonthBlocks: 1, RemainderDays: 0, DayRate: 100000, MonthRate: 2500000, Subtotal: 2500000},
+			},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				price := model.CalculatePrice(pickupDate, test.args.dropoffDate, test.args.dayRate, test.args.monthRate)
+				So(price, ShouldResemble, test.want)
+			})
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/golang-jwt/jwt"
+)
+
+// Principal is the user a request is made on behalf of, the permissions of
+// its scope decide what the request is allowed to do.
+type Principal struct {
+	ID          int64
+	Scope       string
+	Permissions map[string]bool
+}
+
+// Can reports whether the principal is granted one of actions.
+func (p Principal) Can(actions ...string) bool {
+	for _, action := range actions {
+		if p.Permissions[action] {
+			return true
+		}
+	}
+
+	return false
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// GetPrincipal returns the principal put into ctx by WithPrincipal or, for a
+// rest request, the id and scope of its jwt and the permissions granted to it.
+func GetPrincipal(ctx context.Context) (Principal, bool) {
+	if p, ok := ctx.Value(principalKey{}).(Principal); ok {
+		return p, true
+	}
+
+	id, okID := ctx.Value("id").(int64)
+	scope, okScope := ctx.Value("scope").(string)
+	permissions, _ := ctx.Value(PermissionsKey).(map[string]bool)
+	return Principal{
+		ID:          id,
+		Scope:       scope,
+		Permissions: permissions,
+	}, okID && okScope
+}
+
+// ParseToken returns the principal of token once its signature is verified
+// with secret, the same way the jwt of a rest request is. The permissions
+// of the principal are left to be granted.
+func ParseToken(secret []byte, token string) (Principal, error) {
+	parsed, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "unexpected signing method "+t.Method.Alg())
+		}
+		return secret, nil
+	})
+	if err != nil {
+		return Principal{}, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, err, "invalid token")
+	}
+
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok || !parsed.Valid {
+		return Principal{}, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "invalid claims")
+	}
+
+	id, okID := claims["id"].(float64)
+	scope, okScope := claims["scope"].(string)
+	if !okID || !okScope {
+		return Principal{}, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "token has no id or scope")
+	}
+
+	return Principal{
+		ID:    int64(id),
+		Scope: scope,
+	}, nil
+}
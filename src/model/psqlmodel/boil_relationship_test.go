@@ -8,7 +8,9 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("OrderStatusHistoryToOrderUsingOrder", testOrderStatusHistoryToOneOrderUsingOrder)
 	t.Run("OrderToCarUsingCar", testOrderToOneCarUsingCar)
+	t.Run("WebhookDeliveryToWebhookUsingWebhook", testWebhookDeliveryToOneWebhookUsingWebhook)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -19,12 +21,16 @@ func TestOneToOne(t *testing.T) {}
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
 	t.Run("CarToOrders", testCarToManyOrders)
+	t.Run("OrderToOrderStatusHistories", testOrderToManyOrderStatusHistories)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("OrderStatusHistoryToOrderUsingOrderStatusHistories", testOrderStatusHistoryToOneSetOpOrderUsingOrder)
 	t.Run("OrderToCarUsingOrders", testOrderToOneSetOpCarUsingCar)
+	t.Run("WebhookDeliveryToWebhookUsingWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookUsingWebhook)
 }
 
 // TestToOneRemove tests cannot be run in parallel
@@ -43,6 +49,8 @@ func TestOneToOneRemove(t *testing.T) {}
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
 	t.Run("CarToOrders", testCarToManyAddOpOrders)
+	t.Run("OrderToOrderStatusHistories", testOrderToManyAddOpOrderStatusHistories)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyAddOpWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
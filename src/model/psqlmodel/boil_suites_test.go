@@ -12,121 +12,214 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogs)
 	t.Run("Cars", testCars)
+	t.Run("OrderStatusHistories", testOrderStatusHistories)
 	t.Run("Orders", testOrders)
+	t.Run("Outboxes", testOutboxes)
 	t.Run("SchemaMigrations", testSchemaMigrations)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("Webhooks", testWebhooks)
 }
 
 func TestSoftDelete(t *testing.T) {
 	t.Run("Cars", testCarsSoftDelete)
 	t.Run("Orders", testOrdersSoftDelete)
+	t.Run("Webhooks", testWebhooksSoftDelete)
 }
 
 func TestQuerySoftDeleteAll(t *testing.T) {
 	t.Run("Cars", testCarsQuerySoftDeleteAll)
 	t.Run("Orders", testOrdersQuerySoftDeleteAll)
+	t.Run("Webhooks", testWebhooksQuerySoftDeleteAll)
 }
 
 func TestSliceSoftDeleteAll(t *testing.T) {
 	t.Run("Cars", testCarsSliceSoftDeleteAll)
 	t.Run("Orders", testOrdersSliceSoftDeleteAll)
+	t.Run("Webhooks", testWebhooksSliceSoftDeleteAll)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsDelete)
 	t.Run("Cars", testCarsDelete)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesDelete)
 	t.Run("Orders", testOrdersDelete)
+	t.Run("Outboxes", testOutboxesDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("Webhooks", testWebhooksDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsQueryDeleteAll)
 	t.Run("Cars", testCarsQueryDeleteAll)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesQueryDeleteAll)
 	t.Run("Orders", testOrdersQueryDeleteAll)
+	t.Run("Outboxes", testOutboxesQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("Webhooks", testWebhooksQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsSliceDeleteAll)
 	t.Run("Cars", testCarsSliceDeleteAll)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesSliceDeleteAll)
 	t.Run("Orders", testOrdersSliceDeleteAll)
+	t.Run("Outboxes", testOutboxesSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("Webhooks", testWebhooksSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsExists)
 	t.Run("Cars", testCarsExists)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesExists)
 	t.Run("Orders", testOrdersExists)
+	t.Run("Outboxes", testOutboxesExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("Webhooks", testWebhooksExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsFind)
 	t.Run("Cars", testCarsFind)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesFind)
 	t.Run("Orders", testOrdersFind)
+	t.Run("Outboxes", testOutboxesFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("Webhooks", testWebhooksFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsBind)
 	t.Run("Cars", testCarsBind)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesBind)
 	t.Run("Orders", testOrdersBind)
+	t.Run("Outboxes", testOutboxesBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("Webhooks", testWebhooksBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsOne)
 	t.Run("Cars", testCarsOne)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesOne)
 	t.Run("Orders", testOrdersOne)
+	t.Run("Outboxes", testOutboxesOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("Webhooks", testWebhooksOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsAll)
 	t.Run("Cars", testCarsAll)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesAll)
 	t.Run("Orders", testOrdersAll)
+	t.Run("Outboxes", testOutboxesAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("Webhooks", testWebhooksAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsCount)
 	t.Run("Cars", testCarsCount)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesCount)
 	t.Run("Orders", testOrdersCount)
+	t.Run("Outboxes", testOutboxesCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("Webhooks", testWebhooksCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsHooks)
 	t.Run("Cars", testCarsHooks)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesHooks)
 	t.Run("Orders", testOrdersHooks)
+	t.Run("Outboxes", testOutboxesHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesHooks)
+	t.Run("Webhooks", testWebhooksHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsInsert)
+	t.Run("AuditLogs", testAuditLogsInsertWhitelist)
 	t.Run("Cars", testCarsInsert)
 	t.Run("Cars", testCarsInsertWhitelist)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesInsert)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesInsertWhitelist)
 	t.Run("Orders", testOrdersInsert)
 	t.Run("Orders", testOrdersInsertWhitelist)
+	t.Run("Outboxes", testOutboxesInsert)
+	t.Run("Outboxes", testOutboxesInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("Webhooks", testWebhooksInsert)
+	t.Run("Webhooks", testWebhooksInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsReload)
 	t.Run("Cars", testCarsReload)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesReload)
 	t.Run("Orders", testOrdersReload)
+	t.Run("Outboxes", testOutboxesReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("Webhooks", testWebhooksReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsReloadAll)
 	t.Run("Cars", testCarsReloadAll)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesReloadAll)
 	t.Run("Orders", testOrdersReloadAll)
+	t.Run("Outboxes", testOutboxesReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("Webhooks", testWebhooksReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsSelect)
 	t.Run("Cars", testCarsSelect)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesSelect)
 	t.Run("Orders", testOrdersSelect)
+	t.Run("Outboxes", testOutboxesSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("Webhooks", testWebhooksSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsUpdate)
 	t.Run("Cars", testCarsUpdate)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesUpdate)
 	t.Run("Orders", testOrdersUpdate)
+	t.Run("Outboxes", testOutboxesUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("Webhooks", testWebhooksUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsSliceUpdateAll)
 	t.Run("Cars", testCarsSliceUpdateAll)
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesSliceUpdateAll)
 	t.Run("Orders", testOrdersSliceUpdateAll)
+	t.Run("Outboxes", testOutboxesSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("Webhooks", testWebhooksSliceUpdateAll)
 }
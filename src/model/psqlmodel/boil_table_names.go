@@ -4,11 +4,21 @@
 package psqlmodel
 
 var TableNames = struct {
-	Cars             string
-	Orders           string
-	SchemaMigrations string
+	AuditLog           string
+	Cars               string
+	OrderStatusHistory string
+	Orders             string
+	Outbox             string
+	SchemaMigrations   string
+	WebhookDeliveries  string
+	Webhooks           string
 }{
-	Cars:             "cars",
-	Orders:           "orders",
-	SchemaMigrations: "schema_migrations",
+	AuditLog:           "audit_log",
+	Cars:               "cars",
+	OrderStatusHistory: "order_status_history",
+	Orders:             "orders",
+	Outbox:             "outbox",
+	SchemaMigrations:   "schema_migrations",
+	WebhookDeliveries:  "webhook_deliveries",
+	Webhooks:           "webhooks",
 }
@@ -35,6 +35,7 @@ type Car struct {
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedBy null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Version   int       `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *carR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L carL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +53,7 @@ var CarColumns = struct {
 	UpdatedAt string
 	DeletedBy string
 	DeletedAt string
+	Version   string
 }{
 	ID:        "id",
 	CarName:   "car_name",
@@ -64,6 +66,7 @@ var CarColumns = struct {
 	UpdatedAt: "updated_at",
 	DeletedBy: "deleted_by",
 	DeletedAt: "deleted_at",
+	Version:   "version",
 }
 
 var CarTableColumns = struct {
@@ -78,6 +81,7 @@ var CarTableColumns = struct {
 	UpdatedAt string
 	DeletedBy string
 	DeletedAt string
+	Version   string
 }{
 	ID:        "cars.id",
 	CarName:   "cars.car_name",
@@ -90,60 +94,11 @@ var CarTableColumns = struct {
 	UpdatedAt: "cars.updated_at",
 	DeletedBy: "cars.deleted_by",
 	DeletedAt: "cars.deleted_at",
+	Version:   "cars.version",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperfloat64 struct{ field string }
 
 func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -173,27 +128,6 @@ func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Int struct{ field string }
 
 func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
@@ -268,6 +202,7 @@ var CarWhere = struct {
 	UpdatedAt whereHelpertime_Time
 	DeletedBy whereHelpernull_Int
 	DeletedAt whereHelpernull_Time
+	Version   whereHelperint
 }{
 	ID:        whereHelperint{field: "\"cars\".\"id\""},
 	CarName:   whereHelperstring{field: "\"cars\".\"car_name\""},
@@ -280,6 +215,7 @@ var CarWhere = struct {
 	UpdatedAt: whereHelpertime_Time{field: "\"cars\".\"updated_at\""},
 	DeletedBy: whereHelpernull_Int{field: "\"cars\".\"deleted_by\""},
 	DeletedAt: whereHelpernull_Time{field: "\"cars\".\"deleted_at\""},
+	Version:   whereHelperint{field: "\"cars\".\"version\""},
 }
 
 // CarRels is where relationship names are stored.
@@ -310,9 +246,9 @@ func (r *carR) GetOrders() OrderSlice {
 type carL struct{}
 
 var (
-	carAllColumns            = []string{"id", "car_name", "day_rate", "month_rate", "image", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	carAllColumns            = []string{"id", "car_name", "day_rate", "month_rate", "image", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "version"}
 	carColumnsWithoutDefault = []string{"car_name", "day_rate", "month_rate", "image"}
-	carColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	carColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "version"}
 	carPrimaryKeyColumns     = []string{"id"}
 	carGeneratedColumns      = []string{}
 )
@@ -823,7 +823,7 @@ func testCarsSelect(t *testing.T) {
 }
 
 var (
-	carDBTypes = map[string]string{`ID`: `integer`, `CarName`: `character varying`, `DayRate`: `double precision`, `MonthRate`: `double precision`, `Image`: `character varying`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	carDBTypes = map[string]string{`ID`: `integer`, `CarName`: `character varying`, `DayRate`: `double precision`, `MonthRate`: `double precision`, `Image`: `character varying`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`, `Version`: `integer`}
 	_          = bytes.MinRead
 )
 
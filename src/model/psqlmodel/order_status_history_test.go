@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package psqlmodel
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderStatusHistories(t *testing.T) {
+	t.Parallel()
+
+	query := OrderStatusHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderStatusHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderStatusHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrderStatusHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderStatusHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderStatusHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderStatusHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderStatusHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrderStatusHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderStatusHistoryExists to return true, but got false.")
+	}
+}
+
+func testOrderStatusHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderStatusHistoryFound, err := FindOrderStatusHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderStatusHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderStatusHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrderStatusHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderStatusHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrderStatusHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderStatusHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderStatusHistoryOne := &OrderStatusHistory{}
+	orderStatusHistoryTwo := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, orderStatusHistoryOne, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderStatusHistoryTwo, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderStatusHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderStatusHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderStatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderStatusHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderStatusHistoryOne := &OrderStatusHistory{}
+	orderStatusHistoryTwo := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, orderStatusHistoryOne, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderStatusHistoryTwo, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderStatusHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderStatusHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderStatusHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func orderStatusHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderStatusHistory) error {
+	*o = OrderStatusHistory{}
+	return nil
+}
+
+func testOrderStatusHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrderStatusHistory{}
+	o := &OrderStatusHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory object: %s", err)
+	}
+
+	AddOrderStatusHistoryHook(boil.BeforeInsertHook, orderStatusHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryBeforeInsertHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.AfterInsertHook, orderStatusHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryAfterInsertHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.AfterSelectHook, orderStatusHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryAfterSelectHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.BeforeUpdateHook, orderStatusHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryBeforeUpdateHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.AfterUpdateHook, orderStatusHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryAfterUpdateHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.BeforeDeleteHook, orderStatusHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryBeforeDeleteHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.AfterDeleteHook, orderStatusHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryAfterDeleteHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.BeforeUpsertHook, orderStatusHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryBeforeUpsertHooks = []OrderStatusHistoryHook{}
+
+	AddOrderStatusHistoryHook(boil.AfterUpsertHook, orderStatusHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderStatusHistoryAfterUpsertHooks = []OrderStatusHistoryHook{}
+}
+
+func testOrderStatusHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderStatusHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderStatusHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderStatusHistoryToOneOrderUsingOrder(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrderStatusHistory
+	var foreign Order
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, orderDBTypes, false, orderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Order struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrderID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Order().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOrderHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Order) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OrderStatusHistorySlice{&local}
+	if err = local.L.LoadOrder(ctx, tx, false, (*[]*OrderStatusHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Order == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Order = nil
+	if err = local.L.LoadOrder(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Order == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOrderStatusHistoryToOneSetOpOrderUsingOrder(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrderStatusHistory
+	var b, c Order
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderStatusHistoryDBTypes, false, strmangle.SetComplement(orderStatusHistoryPrimaryKeyColumns, orderStatusHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, orderDBTypes, false, strmangle.SetComplement(orderPrimaryKeyColumns, orderColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderDBTypes, false, strmangle.SetComplement(orderPrimaryKeyColumns, orderColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Order{&b, &c} {
+		err = a.SetOrder(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Order != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OrderStatusHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrderID != x.ID {
+			t.Error("foreign key was wrong value", a.OrderID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrderID))
+		reflect.Indirect(reflect.ValueOf(&a.OrderID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OrderID != x.ID {
+			t.Error("foreign key was wrong value", a.OrderID, x.ID)
+		}
+	}
+}
+
+func testOrderStatusHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderStatusHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderStatusHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderStatusHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderStatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderStatusHistoryDBTypes = map[string]string{`ID`: `integer`, `OrderID`: `integer`, `FromStatus`: `character varying`, `ToStatus`: `character varying`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`}
+	_                         = bytes.MinRead
+)
+
+func testOrderStatusHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderStatusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderStatusHistoryAllColumns) == len(orderStatusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderStatusHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderStatusHistoryAllColumns) == len(orderStatusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderStatusHistory{}
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderStatusHistoryDBTypes, true, orderStatusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderStatusHistoryAllColumns, orderStatusHistoryPrimaryKeyColumns) {
+		fields = orderStatusHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderStatusHistoryAllColumns,
+			orderStatusHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderStatusHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOrderStatusHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(orderStatusHistoryAllColumns) == len(orderStatusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OrderStatusHistory{}
+	if err = randomize.Struct(seed, &o, orderStatusHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrderStatusHistory: %s", err)
+	}
+
+	count, err := OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, orderStatusHistoryDBTypes, false, orderStatusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderStatusHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrderStatusHistory: %s", err)
+	}
+
+	count, err = OrderStatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
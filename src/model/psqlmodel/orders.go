@@ -24,154 +24,262 @@ import (
 
 // Order is an object representing the database table.
 type Order struct {
-	ID              int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CarID           int       `boil:"car_id" json:"car_id" toml:"car_id" yaml:"car_id"`
-	OrderDate       time.Time `boil:"order_date" json:"order_date" toml:"order_date" yaml:"order_date"`
-	PickupDate      time.Time `boil:"pickup_date" json:"pickup_date" toml:"pickup_date" yaml:"pickup_date"`
-	DropoffDate     time.Time `boil:"dropoff_date" json:"dropoff_date" toml:"dropoff_date" yaml:"dropoff_date"`
-	PickupLocation  string    `boil:"pickup_location" json:"pickup_location" toml:"pickup_location" yaml:"pickup_location"`
-	PickupLat       float64   `boil:"pickup_lat" json:"pickup_lat" toml:"pickup_lat" yaml:"pickup_lat"`
-	PickupLong      float64   `boil:"pickup_long" json:"pickup_long" toml:"pickup_long" yaml:"pickup_long"`
-	DropoffLocation string    `boil:"dropoff_location" json:"dropoff_location" toml:"dropoff_location" yaml:"dropoff_location"`
-	DropoffLat      float64   `boil:"dropoff_lat" json:"dropoff_lat" toml:"dropoff_lat" yaml:"dropoff_lat"`
-	DropoffLong     float64   `boil:"dropoff_long" json:"dropoff_long" toml:"dropoff_long" yaml:"dropoff_long"`
-	CreatedBy       int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedBy       int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedBy       null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
-	DeletedAt       null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID                  int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CarID               int         `boil:"car_id" json:"car_id" toml:"car_id" yaml:"car_id"`
+	OrderDate           time.Time   `boil:"order_date" json:"order_date" toml:"order_date" yaml:"order_date"`
+	PickupDate          time.Time   `boil:"pickup_date" json:"pickup_date" toml:"pickup_date" yaml:"pickup_date"`
+	DropoffDate         time.Time   `boil:"dropoff_date" json:"dropoff_date" toml:"dropoff_date" yaml:"dropoff_date"`
+	PickupLocation      string      `boil:"pickup_location" json:"pickup_location" toml:"pickup_location" yaml:"pickup_location"`
+	PickupLat           float64     `boil:"pickup_lat" json:"pickup_lat" toml:"pickup_lat" yaml:"pickup_lat"`
+	PickupLong          float64     `boil:"pickup_long" json:"pickup_long" toml:"pickup_long" yaml:"pickup_long"`
+	DropoffLocation     string      `boil:"dropoff_location" json:"dropoff_location" toml:"dropoff_location" yaml:"dropoff_location"`
+	DropoffLat          float64     `boil:"dropoff_lat" json:"dropoff_lat" toml:"dropoff_lat" yaml:"dropoff_lat"`
+	DropoffLong         float64     `boil:"dropoff_long" json:"dropoff_long" toml:"dropoff_long" yaml:"dropoff_long"`
+	CreatedBy           int         `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy           int         `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt           time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy           null.Int    `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt           null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	TotalDays           int         `boil:"total_days" json:"total_days" toml:"total_days" yaml:"total_days"`
+	MonthBlocks         int         `boil:"month_blocks" json:"month_blocks" toml:"month_blocks" yaml:"month_blocks"`
+	RemainderDays       int         `boil:"remainder_days" json:"remainder_days" toml:"remainder_days" yaml:"remainder_days"`
+	DayRate             float64     `boil:"day_rate" json:"day_rate" toml:"day_rate" yaml:"day_rate"`
+	MonthRate           float64     `boil:"month_rate" json:"month_rate" toml:"month_rate" yaml:"month_rate"`
+	Subtotal            float64     `boil:"subtotal" json:"subtotal" toml:"subtotal" yaml:"subtotal"`
+	Status              string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CancellationFee     float64     `boil:"cancellation_fee" json:"cancellation_fee" toml:"cancellation_fee" yaml:"cancellation_fee"`
+	CancellationReason  null.String `boil:"cancellation_reason" json:"cancellation_reason,omitempty" toml:"cancellation_reason" yaml:"cancellation_reason,omitempty"`
+	CancelledAt         null.Time   `boil:"cancelled_at" json:"cancelled_at,omitempty" toml:"cancelled_at" yaml:"cancelled_at,omitempty"`
+	CancelledBy         null.Int    `boil:"cancelled_by" json:"cancelled_by,omitempty" toml:"cancelled_by" yaml:"cancelled_by,omitempty"`
+	OriginalDropoffDate null.Time   `boil:"original_dropoff_date" json:"original_dropoff_date,omitempty" toml:"original_dropoff_date" yaml:"original_dropoff_date,omitempty"`
+	OverdueAt           null.Time   `boil:"overdue_at" json:"overdue_at,omitempty" toml:"overdue_at" yaml:"overdue_at,omitempty"`
+	LateFee             float64     `boil:"late_fee" json:"late_fee" toml:"late_fee" yaml:"late_fee"`
+	Version             int         `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *orderR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OrderColumns = struct {
-	ID              string
-	CarID           string
-	OrderDate       string
-	PickupDate      string
-	DropoffDate     string
-	PickupLocation  string
-	PickupLat       string
-	PickupLong      string
-	DropoffLocation string
-	DropoffLat      string
-	DropoffLong     string
-	CreatedBy       string
-	CreatedAt       string
-	UpdatedBy       string
-	UpdatedAt       string
-	DeletedBy       string
-	DeletedAt       string
+	ID                  string
+	CarID               string
+	OrderDate           string
+	PickupDate          string
+	DropoffDate         string
+	PickupLocation      string
+	PickupLat           string
+	PickupLong          string
+	DropoffLocation     string
+	DropoffLat          string
+	DropoffLong         string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+	TotalDays           string
+	MonthBlocks         string
+	RemainderDays       string
+	DayRate             string
+	MonthRate           string
+	Subtotal            string
+	Status              string
+	CancellationFee     string
+	CancellationReason  string
+	CancelledAt         string
+	CancelledBy         string
+	OriginalDropoffDate string
+	OverdueAt           string
+	LateFee             string
+	Version             string
 }{
-	ID:              "id",
-	CarID:           "car_id",
-	OrderDate:       "order_date",
-	PickupDate:      "pickup_date",
-	DropoffDate:     "dropoff_date",
-	PickupLocation:  "pickup_location",
-	PickupLat:       "pickup_lat",
-	PickupLong:      "pickup_long",
-	DropoffLocation: "dropoff_location",
-	DropoffLat:      "dropoff_lat",
-	DropoffLong:     "dropoff_long",
-	CreatedBy:       "created_by",
-	CreatedAt:       "created_at",
-	UpdatedBy:       "updated_by",
-	UpdatedAt:       "updated_at",
-	DeletedBy:       "deleted_by",
-	DeletedAt:       "deleted_at",
+	ID:                  "id",
+	CarID:               "car_id",
+	OrderDate:           "order_date",
+	PickupDate:          "pickup_date",
+	DropoffDate:         "dropoff_date",
+	PickupLocation:      "pickup_location",
+	PickupLat:           "pickup_lat",
+	PickupLong:          "pickup_long",
+	DropoffLocation:     "dropoff_location",
+	DropoffLat:          "dropoff_lat",
+	DropoffLong:         "dropoff_long",
+	CreatedBy:           "created_by",
+	CreatedAt:           "created_at",
+	UpdatedBy:           "updated_by",
+	UpdatedAt:           "updated_at",
+	DeletedBy:           "deleted_by",
+	DeletedAt:           "deleted_at",
+	TotalDays:           "total_days",
+	MonthBlocks:         "month_blocks",
+	RemainderDays:       "remainder_days",
+	DayRate:             "day_rate",
+	MonthRate:           "month_rate",
+	Subtotal:            "subtotal",
+	Status:              "status",
+	CancellationFee:     "cancellation_fee",
+	CancellationReason:  "cancellation_reason",
+	CancelledAt:         "cancelled_at",
+	CancelledBy:         "cancelled_by",
+	OriginalDropoffDate: "original_dropoff_date",
+	OverdueAt:           "overdue_at",
+	LateFee:             "late_fee",
+	Version:             "version",
 }
 
 var OrderTableColumns = struct {
-	ID              string
-	CarID           string
-	OrderDate       string
-	PickupDate      string
-	DropoffDate     string
-	PickupLocation  string
-	PickupLat       string
-	PickupLong      string
-	DropoffLocation string
-	DropoffLat      string
-	DropoffLong     string
-	CreatedBy       string
-	CreatedAt       string
-	UpdatedBy       string
-	UpdatedAt       string
-	DeletedBy       string
-	DeletedAt       string
+	ID                  string
+	CarID               string
+	OrderDate           string
+	PickupDate          string
+	DropoffDate         string
+	PickupLocation      string
+	PickupLat           string
+	PickupLong          string
+	DropoffLocation     string
+	DropoffLat          string
+	DropoffLong         string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+	TotalDays           string
+	MonthBlocks         string
+	RemainderDays       string
+	DayRate             string
+	MonthRate           string
+	Subtotal            string
+	Status              string
+	CancellationFee     string
+	CancellationReason  string
+	CancelledAt         string
+	CancelledBy         string
+	OriginalDropoffDate string
+	OverdueAt           string
+	LateFee             string
+	Version             string
 }{
-	ID:              "orders.id",
-	CarID:           "orders.car_id",
-	OrderDate:       "orders.order_date",
-	PickupDate:      "orders.pickup_date",
-	DropoffDate:     "orders.dropoff_date",
-	PickupLocation:  "orders.pickup_location",
-	PickupLat:       "orders.pickup_lat",
-	PickupLong:      "orders.pickup_long",
-	DropoffLocation: "orders.dropoff_location",
-	DropoffLat:      "orders.dropoff_lat",
-	DropoffLong:     "orders.dropoff_long",
-	CreatedBy:       "orders.created_by",
-	CreatedAt:       "orders.created_at",
-	UpdatedBy:       "orders.updated_by",
-	UpdatedAt:       "orders.updated_at",
-	DeletedBy:       "orders.deleted_by",
-	DeletedAt:       "orders.deleted_at",
+	ID:                  "orders.id",
+	CarID:               "orders.car_id",
+	OrderDate:           "orders.order_date",
+	PickupDate:          "orders.pickup_date",
+	DropoffDate:         "orders.dropoff_date",
+	PickupLocation:      "orders.pickup_location",
+	PickupLat:           "orders.pickup_lat",
+	PickupLong:          "orders.pickup_long",
+	DropoffLocation:     "orders.dropoff_location",
+	DropoffLat:          "orders.dropoff_lat",
+	DropoffLong:         "orders.dropoff_long",
+	CreatedBy:           "orders.created_by",
+	CreatedAt:           "orders.created_at",
+	UpdatedBy:           "orders.updated_by",
+	UpdatedAt:           "orders.updated_at",
+	DeletedBy:           "orders.deleted_by",
+	DeletedAt:           "orders.deleted_at",
+	TotalDays:           "orders.total_days",
+	MonthBlocks:         "orders.month_blocks",
+	RemainderDays:       "orders.remainder_days",
+	DayRate:             "orders.day_rate",
+	MonthRate:           "orders.month_rate",
+	Subtotal:            "orders.subtotal",
+	Status:              "orders.status",
+	CancellationFee:     "orders.cancellation_fee",
+	CancellationReason:  "orders.cancellation_reason",
+	CancelledAt:         "orders.cancelled_at",
+	CancelledBy:         "orders.cancelled_by",
+	OriginalDropoffDate: "orders.original_dropoff_date",
+	OverdueAt:           "orders.overdue_at",
+	LateFee:             "orders.late_fee",
+	Version:             "orders.version",
 }
 
 // Generated where
 
 var OrderWhere = struct {
-	ID              whereHelperint
-	CarID           whereHelperint
-	OrderDate       whereHelpertime_Time
-	PickupDate      whereHelpertime_Time
-	DropoffDate     whereHelpertime_Time
-	PickupLocation  whereHelperstring
-	PickupLat       whereHelperfloat64
-	PickupLong      whereHelperfloat64
-	DropoffLocation whereHelperstring
-	DropoffLat      whereHelperfloat64
-	DropoffLong     whereHelperfloat64
-	CreatedBy       whereHelperint
-	CreatedAt       whereHelpertime_Time
-	UpdatedBy       whereHelperint
-	UpdatedAt       whereHelpertime_Time
-	DeletedBy       whereHelpernull_Int
-	DeletedAt       whereHelpernull_Time
+	ID                  whereHelperint
+	CarID               whereHelperint
+	OrderDate           whereHelpertime_Time
+	PickupDate          whereHelpertime_Time
+	DropoffDate         whereHelpertime_Time
+	PickupLocation      whereHelperstring
+	PickupLat           whereHelperfloat64
+	PickupLong          whereHelperfloat64
+	DropoffLocation     whereHelperstring
+	DropoffLat          whereHelperfloat64
+	DropoffLong         whereHelperfloat64
+	CreatedBy           whereHelperint
+	CreatedAt           whereHelpertime_Time
+	UpdatedBy           whereHelperint
+	UpdatedAt           whereHelpertime_Time
+	DeletedBy           whereHelpernull_Int
+	DeletedAt           whereHelpernull_Time
+	TotalDays           whereHelperint
+	MonthBlocks         whereHelperint
+	RemainderDays       whereHelperint
+	DayRate             whereHelperfloat64
+	MonthRate           whereHelperfloat64
+	Subtotal            whereHelperfloat64
+	Status              whereHelperstring
+	CancellationFee     whereHelperfloat64
+	CancellationReason  whereHelpernull_String
+	CancelledAt         whereHelpernull_Time
+	CancelledBy         whereHelpernull_Int
+	OriginalDropoffDate whereHelpernull_Time
+	OverdueAt           whereHelpernull_Time
+	LateFee             whereHelperfloat64
+	Version             whereHelperint
 }{
-	ID:              whereHelperint{field: "\"orders\".\"id\""},
-	CarID:           whereHelperint{field: "\"orders\".\"car_id\""},
-	OrderDate:       whereHelpertime_Time{field: "\"orders\".\"order_date\""},
-	PickupDate:      whereHelpertime_Time{field: "\"orders\".\"pickup_date\""},
-	DropoffDate:     whereHelpertime_Time{field: "\"orders\".\"dropoff_date\""},
-	PickupLocation:  whereHelperstring{field: "\"orders\".\"pickup_location\""},
-	PickupLat:       whereHelperfloat64{field: "\"orders\".\"pickup_lat\""},
-	PickupLong:      whereHelperfloat64{field: "\"orders\".\"pickup_long\""},
-	DropoffLocation: whereHelperstring{field: "\"orders\".\"dropoff_location\""},
-	DropoffLat:      whereHelperfloat64{field: "\"orders\".\"dropoff_lat\""},
-	DropoffLong:     whereHelperfloat64{field: "\"orders\".\"dropoff_long\""},
-	CreatedBy:       whereHelperint{field: "\"orders\".\"created_by\""},
-	CreatedAt:       whereHelpertime_Time{field: "\"orders\".\"created_at\""},
-	UpdatedBy:       whereHelperint{field: "\"orders\".\"updated_by\""},
-	UpdatedAt:       whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
-	DeletedBy:       whereHelpernull_Int{field: "\"orders\".\"deleted_by\""},
-	DeletedAt:       whereHelpernull_Time{field: "\"orders\".\"deleted_at\""},
+	ID:                  whereHelperint{field: "\"orders\".\"id\""},
+	CarID:               whereHelperint{field: "\"orders\".\"car_id\""},
+	OrderDate:           whereHelpertime_Time{field: "\"orders\".\"order_date\""},
+	PickupDate:          whereHelpertime_Time{field: "\"orders\".\"pickup_date\""},
+	DropoffDate:         whereHelpertime_Time{field: "\"orders\".\"dropoff_date\""},
+	PickupLocation:      whereHelperstring{field: "\"orders\".\"pickup_location\""},
+	PickupLat:           whereHelperfloat64{field: "\"orders\".\"pickup_lat\""},
+	PickupLong:          whereHelperfloat64{field: "\"orders\".\"pickup_long\""},
+	DropoffLocation:     whereHelperstring{field: "\"orders\".\"dropoff_location\""},
+	DropoffLat:          whereHelperfloat64{field: "\"orders\".\"dropoff_lat\""},
+	DropoffLong:         whereHelperfloat64{field: "\"orders\".\"dropoff_long\""},
+	CreatedBy:           whereHelperint{field: "\"orders\".\"created_by\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"orders\".\"created_at\""},
+	UpdatedBy:           whereHelperint{field: "\"orders\".\"updated_by\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
+	DeletedBy:           whereHelpernull_Int{field: "\"orders\".\"deleted_by\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"orders\".\"deleted_at\""},
+	TotalDays:           whereHelperint{field: "\"orders\".\"total_days\""},
+	MonthBlocks:         whereHelperint{field: "\"orders\".\"month_blocks\""},
+	RemainderDays:       whereHelperint{field: "\"orders\".\"remainder_days\""},
+	DayRate:             whereHelperfloat64{field: "\"orders\".\"day_rate\""},
+	MonthRate:           whereHelperfloat64{field: "\"orders\".\"month_rate\""},
+	Subtotal:            whereHelperfloat64{field: "\"orders\".\"subtotal\""},
+	Status:              whereHelperstring{field: "\"orders\".\"status\""},
+	CancellationFee:     whereHelperfloat64{field: "\"orders\".\"cancellation_fee\""},
+	CancellationReason:  whereHelpernull_String{field: "\"orders\".\"cancellation_reason\""},
+	CancelledAt:         whereHelpernull_Time{field: "\"orders\".\"cancelled_at\""},
+	CancelledBy:         whereHelpernull_Int{field: "\"orders\".\"cancelled_by\""},
+	OriginalDropoffDate: whereHelpernull_Time{field: "\"orders\".\"original_dropoff_date\""},
+	OverdueAt:           whereHelpernull_Time{field: "\"orders\".\"overdue_at\""},
+	LateFee:             whereHelperfloat64{field: "\"orders\".\"late_fee\""},
+	Version:             whereHelperint{field: "\"orders\".\"version\""},
 }
 
 // OrderRels is where relationship names are stored.
 var OrderRels = struct {
-	Car string
+	Car                  string
+	OrderStatusHistories string
 }{
-	Car: "Car",
+	Car:                  "Car",
+	OrderStatusHistories: "OrderStatusHistories",
 }
 
 // orderR is where relationships are stored.
 type orderR struct {
-	Car *Car `boil:"Car" json:"Car" toml:"Car" yaml:"Car"`
+	Car                  *Car                    `boil:"Car" json:"Car" toml:"Car" yaml:"Car"`
+	OrderStatusHistories OrderStatusHistorySlice `boil:"OrderStatusHistories" json:"OrderStatusHistories" toml:"OrderStatusHistories" yaml:"OrderStatusHistories"`
 }
 
 // NewStruct creates a new relationship struct
@@ -186,13 +294,20 @@ func (r *orderR) GetCar() *Car {
 	return r.Car
 }
 
+func (r *orderR) GetOrderStatusHistories() OrderStatusHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.OrderStatusHistories
+}
+
 // orderL is where Load methods for each relationship are stored.
 type orderL struct{}
 
 var (
-	orderAllColumns            = []string{"id", "car_id", "order_date", "pickup_date", "dropoff_date", "pickup_location", "pickup_lat", "pickup_long", "dropoff_location", "dropoff_lat", "dropoff_long", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	orderAllColumns            = []string{"id", "car_id", "order_date", "pickup_date", "dropoff_date", "pickup_location", "pickup_lat", "pickup_long", "dropoff_location", "dropoff_lat", "dropoff_long", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "total_days", "month_blocks", "remainder_days", "day_rate", "month_rate", "subtotal", "status", "cancellation_fee", "cancellation_reason", "cancelled_at", "cancelled_by", "original_dropoff_date", "overdue_at", "late_fee", "version"}
 	orderColumnsWithoutDefault = []string{"car_id", "order_date", "pickup_date", "dropoff_date", "pickup_location", "pickup_lat", "pickup_long", "dropoff_location", "dropoff_lat", "dropoff_long"}
-	orderColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	orderColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "total_days", "month_blocks", "remainder_days", "day_rate", "month_rate", "subtotal", "status", "cancellation_fee", "cancellation_reason", "cancelled_at", "cancelled_by", "original_dropoff_date", "overdue_at", "late_fee", "version"}
 	orderPrimaryKeyColumns     = []string{"id"}
 	orderGeneratedColumns      = []string{}
 )
@@ -533,6 +648,20 @@ func (o *Order) Car(mods ...qm.QueryMod) carQuery {
 	return Cars(queryMods...)
 }
 
+// OrderStatusHistories retrieves all the order_status_history's OrderStatusHistories with an executor.
+func (o *Order) OrderStatusHistories(mods ...qm.QueryMod) orderStatusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"order_status_history\".\"order_id\"=?", o.ID),
+	)
+
+	return OrderStatusHistories(queryMods...)
+}
+
 // LoadCar allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (orderL) LoadCar(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
@@ -654,6 +783,119 @@ func (orderL) LoadCar(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
+// LoadOrderStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadOrderStatusHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`order_status_history`),
+		qm.WhereIn(`order_status_history.order_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order_status_history")
+	}
+
+	var resultSlice []*OrderStatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order_status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order_status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_status_history")
+	}
+
+	if len(orderStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrderStatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderStatusHistoryR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.OrderStatusHistories = append(local.R.OrderStatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderStatusHistoryR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetCarG of the order to the related item.
 // Sets o.R.Car to related.
 // Adds o to related.R.Orders.
@@ -709,6 +951,68 @@ func (o *Order) SetCar(ctx context.Context, exec boil.ContextExecutor, insert bo
 	return nil
 }
 
+// AddOrderStatusHistoriesG adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.OrderStatusHistories.
+// Sets related.R.Order appropriately.
+// Uses the global database handle.
+func (o *Order) AddOrderStatusHistoriesG(ctx context.Context, insert bool, related ...*OrderStatusHistory) error {
+	return o.AddOrderStatusHistories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddOrderStatusHistories adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.OrderStatusHistories.
+// Sets related.R.Order appropriately.
+func (o *Order) AddOrderStatusHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderStatusHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"order_status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderStatusHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderR{
+			OrderStatusHistories: related,
+		}
+	} else {
+		o.R.OrderStatusHistories = append(o.R.OrderStatusHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderStatusHistoryR{
+				Order: o,
+			}
+		} else {
+			rel.R.Order = o
+		}
+	}
+	return nil
+}
+
 // Orders retrieves all the records using an executor.
 func Orders(mods ...qm.QueryMod) orderQuery {
 	mods = append(mods, qm.From("\"orders\""), qmhelper.WhereIsNull("\"orders\".\"deleted_at\""))
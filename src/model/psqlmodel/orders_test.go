@@ -595,6 +595,159 @@ func testOrdersInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testOrderToManyOrderStatusHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Order
+	var b, c OrderStatusHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderDBTypes, true, orderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Order struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderStatusHistoryDBTypes, false, orderStatusHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrderID = a.ID
+	c.OrderID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OrderStatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrderID == b.OrderID {
+			bFound = true
+		}
+		if v.OrderID == c.OrderID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrderSlice{&a}
+	if err = a.L.LoadOrderStatusHistories(ctx, tx, false, (*[]*Order)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderStatusHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OrderStatusHistories = nil
+	if err = a.L.LoadOrderStatusHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderStatusHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrderToManyAddOpOrderStatusHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Order
+	var b, c, d, e OrderStatusHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderDBTypes, false, strmangle.SetComplement(orderPrimaryKeyColumns, orderColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrderStatusHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, orderStatusHistoryDBTypes, false, strmangle.SetComplement(orderStatusHistoryPrimaryKeyColumns, orderStatusHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OrderStatusHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrderStatusHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrderID {
+			t.Error("foreign key was wrong value", a.ID, first.OrderID)
+		}
+		if a.ID != second.OrderID {
+			t.Error("foreign key was wrong value", a.ID, second.OrderID)
+		}
+
+		if first.R.Order != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Order != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OrderStatusHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OrderStatusHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OrderStatusHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testOrderToOneCarUsingCar(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -788,7 +941,7 @@ func testOrdersSelect(t *testing.T) {
 }
 
 var (
-	orderDBTypes = map[string]string{`ID`: `integer`, `CarID`: `integer`, `OrderDate`: `date`, `PickupDate`: `date`, `DropoffDate`: `date`, `PickupLocation`: `character varying`, `PickupLat`: `double precision`, `PickupLong`: `double precision`, `DropoffLocation`: `character varying`, `DropoffLat`: `double precision`, `DropoffLong`: `double precision`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	orderDBTypes = map[string]string{`ID`: `integer`, `CarID`: `integer`, `OrderDate`: `date`, `PickupDate`: `date`, `DropoffDate`: `date`, `PickupLocation`: `character varying`, `PickupLat`: `double precision`, `PickupLong`: `double precision`, `DropoffLocation`: `character varying`, `DropoffLat`: `double precision`, `DropoffLong`: `double precision`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`, `TotalDays`: `integer`, `MonthBlocks`: `integer`, `RemainderDays`: `integer`, `DayRate`: `double precision`, `MonthRate`: `double precision`, `Subtotal`: `double precision`, `Status`: `character varying`, `CancellationFee`: `double precision`, `CancellationReason`: `character varying`, `CancelledAt`: `timestamp with time zone`, `CancelledBy`: `integer`, `OriginalDropoffDate`: `date`, `OverdueAt`: `timestamp with time zone`, `LateFee`: `double precision`, `Version`: `integer`}
 	_            = bytes.MinRead
 )
 
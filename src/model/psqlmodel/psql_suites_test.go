@@ -6,9 +6,19 @@ package psqlmodel
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AuditLogs", testAuditLogsUpsert)
+
 	t.Run("Cars", testCarsUpsert)
 
+	t.Run("OrderStatusHistories", testOrderStatusHistoriesUpsert)
+
 	t.Run("Orders", testOrdersUpsert)
 
+	t.Run("Outboxes", testOutboxesUpsert)
+
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("Webhooks", testWebhooksUpsert)
 }
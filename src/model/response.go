@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
@@ -144,10 +145,114 @@ func (r *SingleOrderResponse) Transform(ctx *gin.Context, log logger.Logger, cod
 	return int(r.Response.Code)
 }
 
+type OrderStatusHistoryResponse struct {
+	Response
+	Data []OrderStatusHistory `json:"data"`
+}
+
+func (r *OrderStatusHistoryResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []OrderStatusHistory{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type QuoteResponse struct {
+	Response
+	Data Quote `json:"data"`
+}
+
+func (r *QuoteResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type OrderExtensionResponse struct {
+	Response
+	Data OrderExtension `json:"data"`
+}
+
+func (r *OrderExtensionResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
 type OrdersResponse struct {
 	Response
 	Data       []Order    `json:"data"`
 	Pagination Pagination `json:"pagination"`
+	Fields     []string   `json:"-"`
+}
+
+// MarshalJSON limits every order of the data to Fields, every field is kept
+// when it is empty.
+func (r OrdersResponse) MarshalJSON() ([]byte, error) {
+	type ordersResponse OrdersResponse
+	data, err := FilterFields(r.Data, r.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		ordersResponse
+		Data interface{} `json:"data"`
+	}{ordersResponse(r), data})
 }
 
 func (r *OrdersResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
@@ -176,3 +281,157 @@ func (r *OrdersResponse) Transform(ctx *gin.Context, log logger.Logger, code int
 
 	return int(r.Response.Code)
 }
+
+type SingleWebhookResponse struct {
+	Response
+	Data Webhook `json:"data"`
+}
+
+func (r *SingleWebhookResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type WebhooksResponse struct {
+	Response
+	Data []Webhook `json:"data"`
+}
+
+func (r *WebhooksResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []Webhook{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type SingleWebhookDeliveryResponse struct {
+	Response
+	Data WebhookDelivery `json:"data"`
+}
+
+func (r *SingleWebhookDeliveryResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type WebhookDeliveriesResponse struct {
+	Response
+	Data       []WebhookDelivery `json:"data"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+func (r *WebhookDeliveriesResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []WebhookDelivery{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type AuditLogsResponse struct {
+	Response
+	Data       []AuditLog `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (r *AuditLogsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []AuditLog{}
+	}
+
+	return int(r.Response.Code)
+}
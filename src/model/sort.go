@@ -0,0 +1,96 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// CarSortColumns are the columns cars may be sorted by.
+var CarSortColumns = map[string]bool{
+	psqlmodel.CarColumns.ID:        true,
+	psqlmodel.CarColumns.CarName:   true,
+	psqlmodel.CarColumns.DayRate:   true,
+	psqlmodel.CarColumns.MonthRate: true,
+	psqlmodel.CarColumns.CreatedAt: true,
+	psqlmodel.CarColumns.UpdatedAt: true,
+}
+
+// OrderSortColumns are the columns orders may be sorted by.
+var OrderSortColumns = map[string]bool{
+	psqlmodel.OrderColumns.ID:              true,
+	psqlmodel.OrderColumns.CarID:           true,
+	psqlmodel.OrderColumns.OrderDate:       true,
+	psqlmodel.OrderColumns.PickupDate:      true,
+	psqlmodel.OrderColumns.DropoffDate:     true,
+	psqlmodel.OrderColumns.PickupLocation:  true,
+	psqlmodel.OrderColumns.DropoffLocation: true,
+	psqlmodel.OrderColumns.Subtotal:        true,
+	psqlmodel.OrderColumns.Status:          true,
+	psqlmodel.OrderColumns.CreatedAt:       true,
+	psqlmodel.OrderColumns.UpdatedAt:       true,
+}
+
+// Sort is a column to sort by.
+type Sort struct {
+	Column string
+	Desc   bool
+}
+
+// ParseSort parses orderBy, a comma separated list of columns each sorted
+// ascending unless prefixed by a minus, such as "-pickup_date,car_id". Every
+// column has to be one of columns and may appear once.
+func ParseSort(orderBy string, columns map[string]bool) ([]Sort, error) {
+	var res []Sort
+	if orderBy == "" {
+		return res, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(orderBy, ",") {
+		field = strings.TrimSpace(field)
+		sort := Sort{Column: field}
+		if strings.HasPrefix(field, "-") {
+			sort = Sort{Column: field[1:], Desc: true}
+		}
+
+		if !columns[sort.Column] {
+			return nil, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidSort, nil, "invalid sort field "+field)
+		}
+
+		if seen[sort.Column] {
+			return nil, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidSort, nil, "duplicate sort field "+field)
+		}
+		seen[sort.Column] = true
+		res = append(res, sort)
+	}
+
+	return res, nil
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return s.Column + " DESC"
+	}
+
+	return s.Column + " ASC"
+}
+
+// getSortQuery returns the order by query of orderBy, which is left unsorted
+// when it fails ParseSort so no client input ever reaches the query.
+func getSortQuery(orderBy string, columns map[string]bool) []qm.QueryMod {
+	var res []qm.QueryMod
+	sorts, err := ParseSort(orderBy, columns)
+	if err != nil {
+		return res
+	}
+
+	for _, sort := range sorts {
+		res = append(res, qm.OrderBy(sort.String()))
+	}
+
+	return res
+}
@@ -0,0 +1,108 @@
+package model_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseSort(t *testing.T) {
+	Convey("test parse sort", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			orderBy  string
+			want     []model.Sort
+		}{
+			{testType: "P", testDesc: "no sort", orderBy: ""},
+			{testType: "P", testDesc: "ascending and descending", orderBy: "-pickup_date,car_id", want: []model.Sort{{Column: "pickup_date", Desc: true}, {Column: "car_id"}}},
+			{testType: "P", testDesc: "spaces around fields", orderBy: " status , -id", want: []model.Sort{{Column: "status"}, {Column: "id", Desc: true}}},
+			{testType: "N", testDesc: "unknown column", orderBy: "secret"},
+			{testType: "N", testDesc: "raw sql", orderBy: "id; DROP TABLE orders"},
+			{testType: "N", testDesc: "raw direction", orderBy: "pickup_date desc"},
+			{testType: "N", testDesc: "duplicate column", orderBy: "id,-id"},
+			{testType: "N", testDesc: "empty field", orderBy: "id,"},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				sorts, err := model.ParseSort(test.orderBy, model.OrderSortColumns)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, svcerr.CodeInvalidSort)
+				} else {
+					So(err, ShouldBeNil)
+					So(sorts, ShouldResemble, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestGetCarsByParamSort(t *testing.T) {
+	Convey("test sort cars by param", t, func() {
+		param := model.GetCarsByParam{OrderBy: null.StringFrom("-day_rate,id")}
+		So(param.Validate(), ShouldBeNil)
+		So(getOrderBy(psqlmodel.Cars(param.GetQuery()...).Query), ShouldEqual, "day_rate DESC, id ASC")
+
+		param.OrderBy = null.StringFrom("pickup_date")
+		So(errormsg.GetErrorCode(param.Validate()), ShouldEqual, svcerr.CodeInvalidSort)
+		So(getOrderBy(psqlmodel.Cars(param.GetQuery()...).Query), ShouldBeEmpty)
+	})
+}
+
+var orderByPattern = regexp.MustCompile(` ORDER BY (.*);$`)
+
+func getOrderBy(q *queries.Query) string {
+	query, _ := queries.BuildQuery(q)
+	match := orderByPattern.FindStringSubmatch(query)
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+func FuzzGetOrdersByParamSort(f *testing.F) {
+	for _, seed := range []string{"", "-pickup_date,car_id", "id;DROP TABLE orders", "status desc", "id,,id", "-", "--id", " id ,-status"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, orderBy string) {
+		param := model.GetOrdersByParam{OrderBy: null.StringFrom(orderBy)}
+		orderByQuery := getOrderBy(psqlmodel.Orders(param.GetQuery()...).Query)
+
+		sorts, err := model.ParseSort(orderBy, model.OrderSortColumns)
+		if param.Validate() == nil != (err == nil) {
+			t.Fatalf("validate and parse sort disagree on %q", orderBy)
+		}
+
+		if err != nil {
+			if orderByQuery != "" {
+				t.Fatalf("invalid sort %q reached the query: %s", orderBy, orderByQuery)
+			}
+			return
+		}
+
+		var want []string
+		for _, sort := range sorts {
+			if !model.OrderSortColumns[sort.Column] {
+				t.Fatalf("column %q of %q is not allowed", sort.Column, orderBy)
+			}
+			want = append(want, sort.String())
+		}
+
+		if orderByQuery != strings.Join(want, ", ") {
+			t.Fatalf("sort %q built %q, want %q", orderBy, orderByQuery, strings.Join(want, ", "))
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package svcerr
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const grpcErrorDomain = "ordersvc"
+
+var grpcCodes = map[int64]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.Aborted,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// ToGRPCStatus converts a wrapped service error into a gRPC status error that
+// carries the svcerr code, so the client side can restore the original message.
+// Only the public message is sent, the debug error stays on the server.
+func ToGRPCStatus(err error) error {
+	errData, ok := err.(*errormsg.ErrorMsg)
+	if !ok {
+		return err
+	}
+
+	code, ok := grpcCodes[errData.WrappedMessage.StatusCode]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	msg := errData.WrappedMessage.Translation.EN
+	st, stErr := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: strconv.FormatInt(errData.Code, 10),
+		Domain: grpcErrorDomain,
+	})
+	if stErr != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+// FromGRPCStatus restores a service error returned by ToGRPCStatus. Errors
+// without a known svcerr code are wrapped as OrderSVCErrorGRPCClient.
+func FromGRPCStatus(err error, customMessage string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errormsg.WrapErr(OrderSVCErrorGRPCClient, err, customMessage)
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != grpcErrorDomain {
+			continue
+		}
+
+		code, err := strconv.Atoi(info.Reason)
+		if err != nil {
+			break
+		}
+
+		if msg, ok := ErrMsg[code]; ok {
+			return errormsg.WrapErr(msg, errors.New(st.Message()), customMessage)
+		}
+	}
+
+	return errormsg.WrapErr(OrderSVCErrorGRPCClient, err, customMessage)
+}
@@ -30,23 +30,52 @@ const (
 	CodeInvalidDropoffLocation
 	CodeInvalidDropoffLat
 	CodeInvalidDropoffLong
+	CodeInvalidExtendDropoffDate
+	CodeInvalidWebhookURL
+	CodeInvalidWebhookEventType
+	CodeInvalidSort
+	CodeInvalidGeoFilter
+	CodeInvalidCursor
+	CodeInvalidFields
 
-	CodeNotAuthorized = 401000
-	CodeNotFound      = 404000
+	CodeNotAuthorized           = 401000
+	CodeNotFound                = 404000
+	CodeVersionMismatch         = 412000
+	CodeVersionRequired         = 428000
+	CodeCarNotAvailable         = 409000
+	CodeInvalidStatusTransition = 409001
+	CodeOrderCancelled          = 409002
+	CodeOrderCompleted          = 409003
+	CodeOrderNotEditable        = 409004
+	CodeOrderNotExtendable      = 409005
+	CodeIdempotencyInProgress   = 409006
+	CodeDeliveryNotReplayable   = 409007
+	CodeIdempotencyKeyMismatch  = 422000
 )
 
 var (
-	OrderSVCPSQLErrorTransaction = ErrMsg[CodePSQLErrorTransaction]
-	OrderSVCPSQLErrorCommit      = ErrMsg[CodePSQLErrorCommit]
-	OrderSVCPSQLErrorRollback    = ErrMsg[CodePSQLErrorRollback]
-	OrderSVCPSQLErrorInsert      = ErrMsg[CodePSQLErrorInsert]
-	OrderSVCPSQLErrorUpdate      = ErrMsg[CodePSQLErrorUpdate]
-	OrderSVCPSQLErrorDelete      = ErrMsg[CodePSQLErrorDelete]
-	OrderSVCPSQLErrorGet         = ErrMsg[CodePSQLErrorGet]
-	OrderSVCNotAuthorized        = ErrMsg[CodeNotAuthorized]
-	OrderSVCNotFound             = ErrMsg[CodeNotFound]
-	OrderSVCBadRequest           = ErrMsg[CodeBadRequest]
-	OrderSVCErrorGRPCClient      = ErrMsg[CodeErrorGRPCClient]
+	OrderSVCPSQLErrorTransaction  = ErrMsg[CodePSQLErrorTransaction]
+	OrderSVCPSQLErrorCommit       = ErrMsg[CodePSQLErrorCommit]
+	OrderSVCPSQLErrorRollback     = ErrMsg[CodePSQLErrorRollback]
+	OrderSVCPSQLErrorInsert       = ErrMsg[CodePSQLErrorInsert]
+	OrderSVCPSQLErrorUpdate       = ErrMsg[CodePSQLErrorUpdate]
+	OrderSVCPSQLErrorDelete       = ErrMsg[CodePSQLErrorDelete]
+	OrderSVCPSQLErrorGet          = ErrMsg[CodePSQLErrorGet]
+	OrderSVCNotAuthorized         = ErrMsg[CodeNotAuthorized]
+	OrderSVCNotFound              = ErrMsg[CodeNotFound]
+	OrderSVCBadRequest            = ErrMsg[CodeBadRequest]
+	OrderSVCErrorGRPCClient       = ErrMsg[CodeErrorGRPCClient]
+	OrderSVCCarNotAvailable       = ErrMsg[CodeCarNotAvailable]
+	OrderSVCInvalidTransition     = ErrMsg[CodeInvalidStatusTransition]
+	OrderSVCOrderCancelled        = ErrMsg[CodeOrderCancelled]
+	OrderSVCOrderCompleted        = ErrMsg[CodeOrderCompleted]
+	OrderSVCOrderNotEditable      = ErrMsg[CodeOrderNotEditable]
+	OrderSVCOrderNotExtendable    = ErrMsg[CodeOrderNotExtendable]
+	OrderSVCIdempotencyProgress   = ErrMsg[CodeIdempotencyInProgress]
+	OrderSVCDeliveryNotReplayable = ErrMsg[CodeDeliveryNotReplayable]
+	OrderSVCIdempotencyMismatch   = ErrMsg[CodeIdempotencyKeyMismatch]
+	OrderSVCVersionMismatch       = ErrMsg[CodeVersionMismatch]
+	OrderSVCVersionRequired       = ErrMsg[CodeVersionRequired]
 
 	OrderSVCCodeInvalidCarName         = ErrMsg[CodeInvalidCarName]
 	OrderSVCCodeInvalidDayRate         = ErrMsg[CodeInvalidDayRate]
@@ -62,6 +91,13 @@ var (
 	OrderSVCCodeInvalidDropoffLocation = ErrMsg[CodeInvalidDropoffLocation]
 	OrderSVCCodeInvalidDropoffLat      = ErrMsg[CodeInvalidDropoffLat]
 	OrderSVCCodeInvalidDropoffLong     = ErrMsg[CodeInvalidDropoffLong]
+	OrderSVCCodeInvalidExtendDropoff   = ErrMsg[CodeInvalidExtendDropoffDate]
+	OrderSVCCodeInvalidWebhookURL      = ErrMsg[CodeInvalidWebhookURL]
+	OrderSVCCodeInvalidWebhookEvent    = ErrMsg[CodeInvalidWebhookEventType]
+	OrderSVCCodeInvalidSort            = ErrMsg[CodeInvalidSort]
+	OrderSVCCodeInvalidGeoFilter       = ErrMsg[CodeInvalidGeoFilter]
+	OrderSVCCodeInvalidCursor          = ErrMsg[CodeInvalidCursor]
+	OrderSVCCodeInvalidFields          = ErrMsg[CodeInvalidFields]
 )
 
 var ErrMsg = map[int]errormsg.Message{
@@ -129,6 +165,94 @@ var ErrMsg = map[int]errormsg.Message{
 			EN: "Access not authorized! Please login again!",
 		},
 	},
+	CodeVersionMismatch: {
+		Code:       CodeVersionMismatch,
+		StatusCode: http.StatusPreconditionFailed,
+		Message:    "Data sudah diubah oleh permintaan lain. Silakan muat ulang data!",
+		Translation: errormsg.Translation{
+			EN: "Data has been changed by another request. Please reload the data!",
+		},
+	},
+	CodeVersionRequired: {
+		Code:       CodeVersionRequired,
+		StatusCode: http.StatusPreconditionRequired,
+		Message:    "Header If-Match harus diisi!",
+		Translation: errormsg.Translation{
+			EN: "If-Match header should not be empty!",
+		},
+	},
+	CodeCarNotAvailable: {
+		Code:       CodeCarNotAvailable,
+		StatusCode: http.StatusConflict,
+		Message:    "Mobil tidak tersedia pada periode yang dipilih!",
+		Translation: errormsg.Translation{
+			EN: "Car is not available for the selected period!",
+		},
+	},
+	CodeInvalidStatusTransition: {
+		Code:       CodeInvalidStatusTransition,
+		StatusCode: http.StatusConflict,
+		Message:    "Perubahan status pesanan tidak diizinkan!",
+		Translation: errormsg.Translation{
+			EN: "Order status transition is not allowed!",
+		},
+	},
+	CodeOrderCancelled: {
+		Code:       CodeOrderCancelled,
+		StatusCode: http.StatusConflict,
+		Message:    "Pesanan sudah dibatalkan!",
+		Translation: errormsg.Translation{
+			EN: "Order is already cancelled!",
+		},
+	},
+	CodeOrderCompleted: {
+		Code:       CodeOrderCompleted,
+		StatusCode: http.StatusConflict,
+		Message:    "Pesanan sudah selesai!",
+		Translation: errormsg.Translation{
+			EN: "Order is already completed!",
+		},
+	},
+	CodeOrderNotEditable: {
+		Code:       CodeOrderNotEditable,
+		StatusCode: http.StatusConflict,
+		Message:    "Pesanan tidak dapat diubah pada status saat ini!",
+		Translation: errormsg.Translation{
+			EN: "Order can not be changed in its current status!",
+		},
+	},
+	CodeOrderNotExtendable: {
+		Code:       CodeOrderNotExtendable,
+		StatusCode: http.StatusConflict,
+		Message:    "Pesanan tidak dapat diperpanjang pada status saat ini!",
+		Translation: errormsg.Translation{
+			EN: "Order can not be extended in its current status!",
+		},
+	},
+	CodeIdempotencyInProgress: {
+		Code:       CodeIdempotencyInProgress,
+		StatusCode: http.StatusConflict,
+		Message:    "Permintaan dengan idempotency key yang sama sedang diproses!",
+		Translation: errormsg.Translation{
+			EN: "A request with the same idempotency key is still in progress!",
+		},
+	},
+	CodeDeliveryNotReplayable: {
+		Code:       CodeDeliveryNotReplayable,
+		StatusCode: http.StatusConflict,
+		Message:    "Pengiriman webhook masih diproses!",
+		Translation: errormsg.Translation{
+			EN: "Webhook delivery is still in progress!",
+		},
+	},
+	CodeIdempotencyKeyMismatch: {
+		Code:       CodeIdempotencyKeyMismatch,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Idempotency key sudah digunakan untuk permintaan yang berbeda!",
+		Translation: errormsg.Translation{
+			EN: "Idempotency key was already used for a different request!",
+		},
+	},
 	CodeBadRequest: {
 		Code:       CodeBadRequest,
 		StatusCode: http.StatusBadRequest,
@@ -265,4 +389,60 @@ var ErrMsg = map[int]errormsg.Message{
 			EN: "Dropoff longitude field should not be empty!",
 		},
 	},
+	CodeInvalidExtendDropoffDate: {
+		Code:       CodeInvalidExtendDropoffDate,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Tanggal pengantaran baru harus setelah tanggal pengantaran saat ini!",
+		Translation: errormsg.Translation{
+			EN: "New dropoff date should be after the current dropoff date!",
+		},
+	},
+	CodeInvalidWebhookURL: {
+		Code:       CodeInvalidWebhookURL,
+		StatusCode: http.StatusBadRequest,
+		Message:    "URL webhook harus berupa URL http atau https yang valid!",
+		Translation: errormsg.Translation{
+			EN: "Webhook url should be a valid http or https url!",
+		},
+	},
+	CodeInvalidWebhookEventType: {
+		Code:       CodeInvalidWebhookEventType,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Tipe event webhook tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid webhook event type!",
+		},
+	},
+	CodeInvalidSort: {
+		Code:       CodeInvalidSort,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Urutan data tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid sort field!",
+		},
+	},
+	CodeInvalidGeoFilter: {
+		Code:       CodeInvalidGeoFilter,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Filter lokasi tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid location filter!",
+		},
+	},
+	CodeInvalidCursor: {
+		Code:       CodeInvalidCursor,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kursor halaman tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid page cursor!",
+		},
+	},
+	CodeInvalidFields: {
+		Code:       CodeInvalidFields,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Field data tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid fields!",
+		},
+	},
 }
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+// GetETag returns the entity tag of a resource stored in the given version.
+func GetETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch returns the version required by an If-Match header. A "*"
+// header matches any version and returns nil.
+func ParseIfMatch(header string) (*int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCVersionRequired, nil, "missing if-match header")
+	}
+
+	if header == "*" {
+		return nil, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "invalid if-match header")
+	}
+
+	return &version, nil
+}
+
+// ValidateVersion returns error when an expected version is given and the
+// stored version differs from it.
+func ValidateVersion(expected *int64, current int) error {
+	if expected != nil && *expected != int64(current) {
+		return errormsg.WrapErr(svcerr.OrderSVCVersionMismatch, nil, fmt.Sprintf("expected version %d, current version %d", *expected, current))
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	version := int64(3)
+	Convey("test parse if-match header", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			header   string
+			want     *int64
+			wantCode int64
+		}{
+			{testType: "P", testDesc: "strong etag", header: model.GetETag(3), want: &version},
+			{testType: "P", testDesc: "weak etag", header: `W/"3"`, want: &version},
+			{testType: "P", testDesc: "any version", header: "*", want: nil},
+			{testType: "N", testDesc: "missing header", header: "", wantCode: svcerr.CodeVersionRequired},
+			{testType: "N", testDesc: "malformed header", header: `"abc"`, wantCode: svcerr.CodeBadRequest},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				res, err := model.ParseIfMatch(test.header)
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+				} else {
+					So(err, ShouldBeNil)
+					So(res, ShouldResemble, test.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,440 @@
+package model
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+
+	WebhookEventHeader     = "X-Carrent-Event"
+	WebhookDeliveryHeader  = "X-Carrent-Delivery"
+	WebhookTimestampHeader = "X-Carrent-Timestamp"
+	WebhookSignatureHeader = "X-Carrent-Signature"
+	// WebhookSignaturePrefix names the algorithm of the signature header value.
+	WebhookSignaturePrefix = "sha256="
+
+	DefaultWebhookBatchSize    int           = 100
+	DefaultWebhookMaxAttempts  int           = 8
+	DefaultWebhookRetryBackoff time.Duration = 10 * time.Second
+	DefaultWebhookTimeout      time.Duration = 5 * time.Second
+	// MaxWebhookRetryBackoff caps the delay between two attempts of a delivery.
+	MaxWebhookRetryBackoff time.Duration = 6 * time.Hour
+
+	// webhookSecretSize is the number of random bytes in a signing secret.
+	webhookSecretSize = 32
+)
+
+// WebhookEventTypes lists the events a webhook can subscribe to.
+var WebhookEventTypes = []string{
+	OrderEventCreated,
+	OrderEventUpdated,
+	OrderEventStatusChanged,
+	OrderEventCancelled,
+	OrderEventDeleted,
+}
+
+type CreateWebhook struct {
+	URL        string   `json:"url" example:"https://partner.example.com/carrent/webhook" validate:"required,url,max=255"`
+	EventTypes []string `json:"event_types" example:"order.created,order.status_changed" validate:"required,min=1"`
+}
+
+func (v *CreateWebhook) Validate() error {
+	u, err := url.Parse(v.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidWebhookURL, err, "invalid webhook url")
+	}
+
+	err = validateWebhookHost(u.Hostname())
+	if err != nil {
+		return err
+	}
+
+	if len(v.EventTypes) == 0 {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidWebhookEvent, nil, "empty webhook event types")
+	}
+
+	for _, eventType := range v.EventTypes {
+		if !IsWebhookEventType(eventType) {
+			return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidWebhookEvent, nil, "invalid webhook event type "+eventType)
+		}
+	}
+
+	return nil
+}
+
+func (v *CreateWebhook) FillGrpcClient() *grpcmodel.CreateWebhookRequest {
+	return &grpcmodel.CreateWebhookRequest{
+		Url:        v.URL,
+		EventTypes: v.EventTypes,
+	}
+}
+
+// validateWebhookHost returns an error when host is an address that is not
+// public or a localhost name. Other names are not resolved here, the address
+// they resolve to is checked when a delivery connects.
+func validateWebhookHost(host string) error {
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if name == "localhost" || strings.HasSuffix(name, ".localhost") {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidWebhookURL, nil, "webhook host "+host+" is not public")
+	}
+
+	ip := net.ParseIP(host)
+	if ip != nil && !IsPublicIP(ip) {
+		return errormsg.WrapErr(svcerr.OrderSVCCodeInvalidWebhookURL, nil, "webhook host "+host+" is not public")
+	}
+
+	return nil
+}
+
+// IsPublicIP reports whether ip is neither a loopback, link-local, private
+// nor unspecified address.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified()
+}
+
+// IsWebhookEventType reports whether a webhook can subscribe to eventType.
+func IsWebhookEventType(eventType string) bool {
+	for _, val := range WebhookEventTypes {
+		if val == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Webhook struct {
+	ID         int64    `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// Secret signs the deliveries of the webhook, it is only returned once
+	// when the webhook is created.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedBy int64     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedBy int64     `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhook_id"`
+	OutboxID       int64           `json:"outbox_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status"`
+	Attempts       int64           `json:"attempts"`
+	ResponseStatus null.Int64      `json:"response_status" swaggertype:"primitive,integer"`
+	LastError      null.String     `json:"last_error" swaggertype:"primitive,string"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    null.Time       `json:"delivered_at" swaggertype:"primitive,string" example:"2019-08-13T00:00:00Z"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// WebhookPayload is the body posted to a webhook. ID is the id of the event,
+// it is the same on every attempt so receivers can drop duplicates.
+type WebhookPayload struct {
+	ID        int64           `json:"id"`
+	EventType string          `json:"event_type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type GetWebhookDeliveriesByParam struct {
+	WebhookID int64       `schema:"-" json:"webhook_id"`
+	Status    null.String `schema:"status" json:"status"`
+	Limit     int64       `schema:"limit" json:"limit"`
+	Page      int64       `schema:"page" json:"page"`
+}
+
+func (g *GetWebhookDeliveriesByParam) GetQuery() []qm.QueryMod {
+	res := []qm.QueryMod{
+		qm.Where("webhook_id=?", g.WebhookID),
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("status=?", g.Status.String))
+	}
+
+	return res
+}
+
+func (g *GetWebhookDeliveriesByParam) FillGrpcClient() *grpcmodel.GetWebhookDeliveriesRequest {
+	return &grpcmodel.GetWebhookDeliveriesRequest{
+		WebhookId: g.WebhookID,
+		Status:    g.Status.Ptr(),
+		Limit:     g.Limit,
+		Page:      g.Page,
+	}
+}
+
+// NewWebhookDelivery returns a pending delivery of event to the webhook.
+func NewWebhookDelivery(webhookID int, event OutboxEvent) *psqlmodel.WebhookDelivery {
+	return &psqlmodel.WebhookDelivery{
+		WebhookID: webhookID,
+		OutboxID:  int(event.ID),
+		EventType: event.EventType,
+		Payload:   []byte(event.Payload),
+		Status:    WebhookDeliveryStatusPending,
+	}
+}
+
+// GetOrderEventOwner returns the creator of the order an order event was
+// recorded for, which is read from its payload.
+func GetOrderEventOwner(event OutboxEvent) (int64, error) {
+	var order struct {
+		CreatedBy int64 `json:"created_by"`
+	}
+	err := json.Unmarshal(event.Payload, &order)
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal order event")
+	}
+
+	return order.CreatedBy, nil
+}
+
+func NewWebhookPayload(v *psqlmodel.WebhookDelivery) WebhookPayload {
+	return WebhookPayload{
+		ID:        int64(v.OutboxID),
+		EventType: v.EventType,
+		CreatedAt: v.CreatedAt,
+		Data:      json.RawMessage(v.Payload),
+	}
+}
+
+// GenerateWebhookSecret returns a random hex encoded secret to sign deliveries.
+func GenerateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretSize)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error generate webhook secret")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// GetWebhookSignature returns the signature header value of a delivery body
+// sent at timestamp, that is the hex HMAC-SHA256 of "timestamp.body" keyed
+// with the webhook secret. Signing the timestamp lets receivers reject replays.
+func GetWebhookSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetWebhookRetryAt returns when a delivery that failed its attempts-th try is
+// retried, the backoff doubles with every attempt up to MaxWebhookRetryBackoff.
+func GetWebhookRetryAt(now time.Time, attempts int, backoff time.Duration) time.Time {
+	return getRetryAt(now, attempts, backoff, MaxWebhookRetryBackoff)
+}
+
+func TransformSingleWebhookReply(v *psqlmodel.Webhook) *grpcmodel.SingleWebhookReply {
+	return &grpcmodel.SingleWebhookReply{
+		Id:         int64(v.ID),
+		Url:        v.URL,
+		EventTypes: v.EventTypes,
+		CreatedBy:  int64(v.CreatedBy),
+		CreatedAt:  v.CreatedAt.Format(time.RFC3339),
+		UpdatedBy:  int64(v.UpdatedBy),
+		UpdatedAt:  v.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func TransformWebhooksReply(v *psqlmodel.WebhookSlice) *grpcmodel.GetWebhooksReply {
+	var data []*grpcmodel.SingleWebhookReply
+	for _, val := range *v {
+		data = append(data, TransformSingleWebhookReply(val))
+	}
+
+	return &grpcmodel.GetWebhooksReply{
+		Data: data,
+	}
+}
+
+func TransformSingleWebhookReplyToWebhook(ctx context.Context, v *grpcmodel.SingleWebhookReply, log logger.Logger) Webhook {
+	if v == nil {
+		return Webhook{}
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339, v.UpdatedAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	return Webhook{
+		ID:         v.Id,
+		URL:        v.Url,
+		EventTypes: v.EventTypes,
+		Secret:     v.GetSecret(),
+		CreatedBy:  v.CreatedBy,
+		CreatedAt:  createdAt,
+		UpdatedBy:  v.UpdatedBy,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func TransformWebhooksReplyToWebhooks(ctx context.Context, v *grpcmodel.GetWebhooksReply, log logger.Logger) []Webhook {
+	var res []Webhook
+	for _, val := range v.Data {
+		res = append(res, TransformSingleWebhookReplyToWebhook(ctx, val, log))
+	}
+
+	return res
+}
+
+func TransformWebhookDeliveryReply(v *psqlmodel.WebhookDelivery) *grpcmodel.WebhookDeliveryReply {
+	res := &grpcmodel.WebhookDeliveryReply{
+		Id:            int64(v.ID),
+		WebhookId:     int64(v.WebhookID),
+		OutboxId:      int64(v.OutboxID),
+		EventType:     v.EventType,
+		Payload:       string(v.Payload),
+		Status:        v.Status,
+		Attempts:      int64(v.Attempts),
+		LastError:     v.LastError.Ptr(),
+		NextAttemptAt: v.NextAttemptAt.Format(time.RFC3339),
+		CreatedAt:     v.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     v.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if v.ResponseStatus.Valid {
+		responseStatus := int64(v.ResponseStatus.Int)
+		res.ResponseStatus = &responseStatus
+	}
+
+	if v.DeliveredAt.Valid {
+		deliveredAt := v.DeliveredAt.Time.Format(time.RFC3339)
+		res.DeliveredAt = &deliveredAt
+	}
+
+	return res
+}
+
+func TransformWebhookDeliveriesReply(v *psqlmodel.WebhookDeliverySlice, p Pagination) *grpcmodel.GetWebhookDeliveriesReply {
+	var data []*grpcmodel.WebhookDeliveryReply
+	for _, val := range *v {
+		data = append(data, TransformWebhookDeliveryReply(val))
+	}
+
+	return &grpcmodel.GetWebhookDeliveriesReply{
+		Data: data,
+		Pagination: &grpcmodel.Pagination{
+			CurrentPage:    p.CurrentPage,
+			CurrentElement: p.CurrentElements,
+			TotalPages:     p.TotalPages,
+			TotalElements:  p.TotalElements,
+			SortBy:         p.SortBy,
+		},
+	}
+}
+
+func TransformWebhookDeliveryReplyToDelivery(ctx context.Context, v *grpcmodel.WebhookDeliveryReply, log logger.Logger) WebhookDelivery {
+	if v == nil {
+		return WebhookDelivery{}
+	}
+
+	nextAttemptAt, err := time.Parse(time.RFC3339, v.NextAttemptAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339, v.UpdatedAt)
+	if err != nil {
+		log.Error(ctx, err, "error parsing time")
+	}
+
+	deliveredAt := null.Time{}
+	if v.DeliveredAt != nil {
+		tParse, err := time.Parse(time.RFC3339, *v.DeliveredAt)
+		if err != nil {
+			log.Error(ctx, err, "error parsing time")
+		}
+		deliveredAt = null.TimeFrom(tParse)
+	}
+
+	return WebhookDelivery{
+		ID:             v.Id,
+		WebhookID:      v.WebhookId,
+		OutboxID:       v.OutboxId,
+		EventType:      v.EventType,
+		Payload:        json.RawMessage(v.Payload),
+		Status:         v.Status,
+		Attempts:       v.Attempts,
+		ResponseStatus: null.Int64FromPtr(v.ResponseStatus),
+		LastError:      null.StringFromPtr(v.LastError),
+		NextAttemptAt:  nextAttemptAt,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+func TransformWebhookDeliveriesReplyToDeliveries(ctx context.Context, v *grpcmodel.GetWebhookDeliveriesReply, log logger.Logger) ([]WebhookDelivery, Pagination) {
+	var (
+		deliveries []WebhookDelivery
+		pagination Pagination
+	)
+
+	for _, val := range v.Data {
+		deliveries = append(deliveries, TransformWebhookDeliveryReplyToDelivery(ctx, val, log))
+	}
+
+	if v.Pagination != nil {
+		pagination = Pagination{
+			CurrentPage:     v.Pagination.CurrentPage,
+			CurrentElements: v.Pagination.CurrentElement,
+			TotalPages:      v.Pagination.TotalPages,
+			TotalElements:   v.Pagination.TotalElements,
+			SortBy:          v.Pagination.SortBy,
+		}
+	}
+
+	return deliveries, pagination
+}
+
+func TransformGetWebhookDeliveriesRequestToParam(v *grpcmodel.GetWebhookDeliveriesRequest) GetWebhookDeliveriesByParam {
+	return GetWebhookDeliveriesByParam{
+		WebhookID: v.WebhookId,
+		Status:    null.StringFromPtr(v.Status),
+		Limit:     v.Limit,
+		Page:      v.Page,
+	}
+}
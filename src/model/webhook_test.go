@@ -0,0 +1,86 @@
+package model_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetWebhookSignature(t *testing.T) {
+	Convey("test get webhook signature", t, func() {
+		body := []byte(`{"id":1}`)
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte("1706868000." + string(body)))
+		want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+		So(model.GetWebhookSignature("secret", 1706868000, body), ShouldEqual, want)
+		So(model.GetWebhookSignature("secret", 1706868001, body), ShouldNotEqual, want)
+		So(model.GetWebhookSignature("other", 1706868000, body), ShouldNotEqual, want)
+	})
+}
+
+func TestCreateWebhookValidate(t *testing.T) {
+	Convey("test validate create webhook", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			data     model.CreateWebhook
+			wantCode int64
+		}{
+			{testType: "P", testDesc: "valid webhook", data: model.CreateWebhook{URL: "https://93.184.216.34/hook", EventTypes: []string{model.OrderEventCreated, model.OrderEventCancelled}}},
+			{testType: "P", testDesc: "host name left to the delivery to check", data: model.CreateWebhook{URL: "https://hooks.example.invalid/carrent", EventTypes: []string{model.OrderEventCreated}}},
+			{testType: "N", testDesc: "url without http scheme", data: model.CreateWebhook{URL: "ftp://93.184.216.34/hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "url without host", data: model.CreateWebhook{URL: "https:///hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "localhost name", data: model.CreateWebhook{URL: "http://localhost:8080/hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "loopback ipv6 address", data: model.CreateWebhook{URL: "http://[::1]/hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "link-local metadata address", data: model.CreateWebhook{URL: "http://169.254.169.254/latest", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "private address", data: model.CreateWebhook{URL: "https://10.0.0.8/hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "unspecified address", data: model.CreateWebhook{URL: "https://0.0.0.0/hook", EventTypes: []string{model.OrderEventCreated}}, wantCode: svcerr.CodeInvalidWebhookURL},
+			{testType: "N", testDesc: "unknown event type", data: model.CreateWebhook{URL: "https://93.184.216.34/hook", EventTypes: []string{"car.created"}}, wantCode: svcerr.CodeInvalidWebhookEventType},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				err := test.data.Validate()
+				if test.testType == "N" {
+					So(err, ShouldNotBeNil)
+					So(errormsg.GetErrorCode(err), ShouldEqual, test.wantCode)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestGetWebhookRetryAt(t *testing.T) {
+	now := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	Convey("test get webhook retry at", t, func() {
+		So(model.GetWebhookRetryAt(now, 1, 10*time.Second), ShouldEqual, now.Add(10*time.Second))
+		So(model.GetWebhookRetryAt(now, 4, 10*time.Second), ShouldEqual, now.Add(80*time.Second))
+		So(model.GetWebhookRetryAt(now, 30, 10*time.Second), ShouldEqual, now.Add(model.MaxWebhookRetryBackoff))
+	})
+}
+
+func TestGetOrderEventOwner(t *testing.T) {
+	Convey("test get order event owner", t, func() {
+		Convey("0 - [P] : test creator of payload", func() {
+			owner, err := model.GetOrderEventOwner(model.OutboxEvent{Payload: []byte(`{"id":3,"created_by":7}`)})
+			So(err, ShouldBeNil)
+			So(owner, ShouldEqual, 7)
+		})
+
+		Convey("1 - [N] : test invalid payload", func() {
+			_, err := model.GetOrderEventOwner(model.OutboxEvent{Payload: []byte(`[`)})
+			So(errormsg.GetErrorCode(err), ShouldEqual, svcerr.CodeBadRequest)
+		})
+	})
+}
@@ -0,0 +1,103 @@
+package policy
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+)
+
+// Config maps every role, which is the scope of a token, to the actions it
+// is permitted to take.
+type Config struct {
+	Roles map[string][]string `mapstructure:"roles"`
+}
+
+// DefaultRoles are the roles used when none is configured.
+var DefaultRoles = map[string][]string{
+	model.SuperAdminScope: {
+		model.PermissionCarRead,
+		model.PermissionCarWrite,
+		model.PermissionOrderReadAny,
+		model.PermissionOrderWriteAny,
+		model.PermissionOrderCancel,
+		model.PermissionOrderTransition,
+		model.PermissionOrderDelete,
+		model.PermissionWebhookManage,
+		model.PermissionAuditRead,
+	},
+	model.StoreScope: {
+		model.PermissionCarRead,
+		model.PermissionCarWrite,
+		model.PermissionOrderReadAny,
+		model.PermissionOrderWriteAny,
+		model.PermissionOrderCancel,
+		model.PermissionOrderTransition,
+		model.PermissionWebhookManage,
+	},
+	model.CustomerScope: {
+		model.PermissionCarRead,
+		model.PermissionOrderReadOwn,
+		model.PermissionOrderWriteOwn,
+		model.PermissionOrderCancel,
+		model.PermissionWebhookManage,
+	},
+}
+
+// Permission grants principals the actions of their role.
+type Permission struct {
+	roles map[string]map[string]bool
+}
+
+func New(conf Config) *Permission {
+	roles := conf.Roles
+	if len(roles) == 0 {
+		roles = DefaultRoles
+	}
+
+	p := &Permission{
+		roles: make(map[string]map[string]bool, len(roles)),
+	}
+	for role, actions := range roles {
+		p.roles[role] = make(map[string]bool, len(actions))
+		for _, action := range actions {
+			p.roles[role][action] = true
+		}
+	}
+
+	return p
+}
+
+// Grant returns principal with the actions of its role.
+func (p *Permission) Grant(principal model.Principal) model.Principal {
+	principal.Permissions = p.roles[principal.Scope]
+	return principal
+}
+
+// Authorize returns the principal of ctx granted the actions of its role, or
+// an error unless one of actions is among them.
+func (p *Permission) Authorize(ctx context.Context, actions ...string) (model.Principal, error) {
+	principal, _ := model.GetPrincipal(ctx)
+	principal = p.Grant(principal)
+	if !principal.Can(actions...) {
+		return principal, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "scope "+principal.Scope+" is not permitted to "+strings.Join(actions, " or "))
+	}
+
+	return principal, nil
+}
+
+// GetRoles returns the roles permitted action, sorted by name.
+func (p *Permission) GetRoles(action string) []string {
+	var res []string
+	for role, actions := range p.roles {
+		if actions[action] {
+			res = append(res, role)
+		}
+	}
+
+	sort.Strings(res)
+	return res
+}
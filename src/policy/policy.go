@@ -0,0 +1,121 @@
+// Package policy decides what the principal of a request is allowed to do.
+// The actions of every role are granted by a Permission, which the rest and
+// grpc handlers check per route and method, and the checks below apply them
+// to the resources a request touches.
+package policy
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/volatiletech/null/v8"
+)
+
+// CanManageCar returns an error unless the principal of ctx may create,
+// update or delete cars.
+func CanManageCar(ctx context.Context) error {
+	p, _ := model.GetPrincipal(ctx)
+	if p.Can(model.PermissionCarWrite) {
+		return nil
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "scope "+p.Scope+" may not manage cars")
+}
+
+// CanCreateOrder returns an error unless the principal of ctx may create an
+// order on behalf of createdBy, an own write only allows it for themselves.
+func CanCreateOrder(ctx context.Context, createdBy int64) error {
+	p, _ := model.GetPrincipal(ctx)
+	owner, err := getOwner(p, model.PermissionOrderWriteAny, model.PermissionOrderWriteOwn)
+	if err != nil {
+		return err
+	}
+
+	if owner.Valid && createdBy != owner.Int64 {
+		return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "order is created for another customer")
+	}
+
+	return nil
+}
+
+// GetOrderOwner returns the creator the orders seen by the principal of ctx
+// are limited to, it is unset for a principal who may read any order.
+func GetOrderOwner(ctx context.Context) (null.Int64, error) {
+	p, _ := model.GetPrincipal(ctx)
+	return getOwner(p, model.PermissionOrderReadAny, model.PermissionOrderReadOwn)
+}
+
+// CanAccessOrder returns an error unless the principal of ctx may read order.
+func CanAccessOrder(ctx context.Context, order *psqlmodel.Order) error {
+	owner, err := GetOrderOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	if owner.Valid && int64(order.CreatedBy) != owner.Int64 {
+		return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "order is created by another customer")
+	}
+
+	return nil
+}
+
+// CanChangeOrder returns an error unless the principal of ctx may update or
+// extend order.
+func CanChangeOrder(ctx context.Context, order *psqlmodel.Order) error {
+	p, _ := model.GetPrincipal(ctx)
+	owner, err := getOwner(p, model.PermissionOrderWriteAny, model.PermissionOrderWriteOwn)
+	if err != nil {
+		return err
+	}
+
+	if owner.Valid && int64(order.CreatedBy) != owner.Int64 {
+		return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "order is created by another customer")
+	}
+
+	return nil
+}
+
+// CanUpdateOrderStatus returns an error unless the principal of ctx may move
+// order to status. Cancelling needs the cancel action and any other status
+// the transition action, on top of access to the order.
+func CanUpdateOrderStatus(ctx context.Context, order *psqlmodel.Order, status string) error {
+	action := model.PermissionOrderTransition
+	if status == model.OrderStatusCancelled {
+		action = model.PermissionOrderCancel
+	}
+
+	p, _ := model.GetPrincipal(ctx)
+	if !p.Can(action) {
+		return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "scope "+p.Scope+" may not move orders to "+status)
+	}
+
+	return CanAccessOrder(ctx, order)
+}
+
+// CanDeleteOrder returns an error unless the principal of ctx may delete
+// orders.
+func CanDeleteOrder(ctx context.Context) error {
+	p, _ := model.GetPrincipal(ctx)
+	if p.Can(model.PermissionOrderDelete) {
+		return nil
+	}
+
+	return errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "scope "+p.Scope+" may not delete orders")
+}
+
+// getOwner returns the creator p is limited to when it is only granted the
+// own action, it is unset when p is granted the any action.
+func getOwner(p model.Principal, anyAction, ownAction string) (null.Int64, error) {
+	if p.Can(anyAction) {
+		return null.Int64{}, nil
+	}
+
+	if p.Can(ownAction) {
+		return null.Int64From(p.ID), nil
+	}
+
+	return null.Int64{}, errormsg.WrapErr(svcerr.OrderSVCNotAuthorized, nil, "scope "+p.Scope+" may not "+ownAction)
+}
@@ -0,0 +1,160 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/volatiletech/null/v8"
+)
+
+var permission = policy.New(policy.Config{})
+
+func TestAuthorize(t *testing.T) {
+	Convey("test authorize", t, func() {
+		permission := policy.New(policy.Config{
+			Roles: map[string][]string{
+				model.StoreScope: {model.PermissionCarRead, model.PermissionOrderReadAny},
+			},
+		})
+		tests := []struct {
+			testType string
+			testDesc string
+			scope    string
+			actions  []string
+			wantErr  bool
+		}{
+			{testType: "P", testDesc: "configured action", scope: model.StoreScope, actions: []string{model.PermissionCarRead}},
+			{testType: "P", testDesc: "one of actions", scope: model.StoreScope, actions: []string{model.PermissionOrderReadOwn, model.PermissionOrderReadAny}},
+			{testType: "N", testDesc: "action missing from role", scope: model.StoreScope, actions: []string{model.PermissionCarWrite}, wantErr: true},
+			{testType: "N", testDesc: "role missing from config", scope: model.SuperAdminScope, actions: []string{model.PermissionCarRead}, wantErr: true},
+			{testType: "N", testDesc: "no action", scope: model.StoreScope, wantErr: true},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				ctx := model.WithPrincipal(context.Background(), model.Principal{ID: 5, Scope: test.scope})
+				principal, err := permission.Authorize(ctx, test.actions...)
+				So(err != nil, ShouldEqual, test.wantErr)
+				So(principal.ID, ShouldEqual, 5)
+			})
+		}
+	})
+}
+
+func TestGetRoles(t *testing.T) {
+	Convey("test get roles permitted an action", t, func() {
+		Convey("0 - [P] : test roles sorted by name", func() {
+			So(permission.GetRoles(model.PermissionOrderReadAny), ShouldResemble, []string{model.StoreScope, model.SuperAdminScope})
+		})
+
+		Convey("1 - [P] : test no role", func() {
+			So(permission.GetRoles("order:archive"), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestCanManageCar(t *testing.T) {
+	Convey("test can manage car", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			scope    string
+			wantErr  bool
+		}{
+			{testType: "P", testDesc: "super admin", scope: model.SuperAdminScope},
+			{testType: "P", testDesc: "store", scope: model.StoreScope},
+			{testType: "N", testDesc: "customer", scope: model.CustomerScope, wantErr: true},
+			{testType: "N", testDesc: "no principal", wantErr: true},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				ctx := model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 5, Scope: test.scope}))
+				err := policy.CanManageCar(ctx)
+				So(err != nil, ShouldEqual, test.wantErr)
+			})
+		}
+	})
+}
+
+func TestGetOrderOwner(t *testing.T) {
+	Convey("test get order owner", t, func() {
+		tests := []struct {
+			testType string
+			testDesc string
+			scope    string
+			want     null.Int64
+			wantErr  bool
+		}{
+			{testType: "P", testDesc: "super admin sees every order", scope: model.SuperAdminScope},
+			{testType: "P", testDesc: "store sees every order", scope: model.StoreScope},
+			{testType: "P", testDesc: "customer sees own orders", scope: model.CustomerScope, want: null.Int64From(5)},
+			{testType: "N", testDesc: "no principal", wantErr: true},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				ctx := model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 5, Scope: test.scope}))
+				owner, err := policy.GetOrderOwner(ctx)
+				So(err != nil, ShouldEqual, test.wantErr)
+				So(owner, ShouldResemble, test.want)
+			})
+		}
+	})
+}
+
+func TestCanUpdateOrderStatus(t *testing.T) {
+	Convey("test can update order status", t, func() {
+		order := &psqlmodel.Order{ID: 3, CreatedBy: 5}
+		tests := []struct {
+			testType  string
+			testDesc  string
+			principal model.Principal
+			status    string
+			wantErr   bool
+		}{
+			{testType: "P", testDesc: "store confirms any order", principal: model.Principal{ID: 9, Scope: model.StoreScope}, status: model.OrderStatusConfirmed},
+			{testType: "P", testDesc: "customer cancels own order", principal: model.Principal{ID: 5, Scope: model.CustomerScope}, status: model.OrderStatusCancelled},
+			{testType: "N", testDesc: "customer confirms own order", principal: model.Principal{ID: 5, Scope: model.CustomerScope}, status: model.OrderStatusConfirmed, wantErr: true},
+			{testType: "N", testDesc: "customer cancels other order", principal: model.Principal{ID: 6, Scope: model.CustomerScope}, status: model.OrderStatusCancelled, wantErr: true},
+		}
+		for idx, test := range tests {
+			Convey(fmt.Sprintf("%d - [%s] : %s", idx, test.testType, test.testDesc), func() {
+				ctx := model.WithPrincipal(context.Background(), permission.Grant(test.principal))
+				err := policy.CanUpdateOrderStatus(ctx, order, test.status)
+				So(err != nil, ShouldEqual, test.wantErr)
+			})
+		}
+	})
+}
+
+func TestCanDeleteOrder(t *testing.T) {
+	Convey("test can delete order", t, func() {
+		ctx := model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 1, Scope: model.SuperAdminScope}))
+		So(policy.CanDeleteOrder(ctx), ShouldBeNil)
+
+		ctx = model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 1, Scope: model.StoreScope}))
+		So(policy.CanDeleteOrder(ctx), ShouldNotBeNil)
+	})
+}
+
+func TestCanChangeOrder(t *testing.T) {
+	Convey("test can change order", t, func() {
+		order := &psqlmodel.Order{ID: 3, CreatedBy: 5}
+
+		ctx := model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 5, Scope: model.CustomerScope}))
+		So(policy.CanChangeOrder(ctx, order), ShouldBeNil)
+		So(policy.CanCreateOrder(ctx, 5), ShouldBeNil)
+		So(policy.CanCreateOrder(ctx, 6), ShouldNotBeNil)
+
+		ctx = model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 6, Scope: model.CustomerScope}))
+		So(policy.CanChangeOrder(ctx, order), ShouldNotBeNil)
+
+		ctx = model.WithPrincipal(context.Background(), permission.Grant(model.Principal{ID: 9, Scope: model.StoreScope}))
+		So(policy.CanChangeOrder(ctx, order), ShouldBeNil)
+		So(policy.CanCreateOrder(ctx, 5), ShouldBeNil)
+	})
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/audit"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditDep struct {
+	log   logger.Logger
+	conf  Conf
+	audit audit.AuditInterface
+}
+
+type Conf struct{}
+
+type AuditInterface interface {
+	GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error)
+	GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error)
+}
+
+func New(conf Conf, logger *logger.Logger, audit audit.AuditInterface) AuditInterface {
+	return &AuditDep{
+		conf:  conf,
+		log:   *logger,
+		audit: audit,
+	}
+}
+
+func (a *AuditDep) GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error) {
+	auditLogs, err := a.audit.GetByParamGRPC(ctx, v.FillGrpcClient())
+	if err != nil {
+		return []model.AuditLog{}, model.Pagination{}, err
+	}
+
+	result, pagination := model.TransformAuditLogsReplyToAuditLogs(ctx, auditLogs, a.log)
+	return result, pagination, nil
+}
+
+func (a *AuditDep) GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error) {
+	param := model.TransformGetAuditLogsRequestToParam(*ctx, v, a.log)
+	auditLogs, pagination, err := a.audit.GetByParam(ctx, &param)
+	if err != nil {
+		return &grpcmodel.GetAuditLogsReply{}, err
+	}
+
+	return model.TransformAuditLogsReply(&auditLogs, pagination), nil
+}
@@ -2,6 +2,8 @@ package car
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
@@ -10,8 +12,10 @@ import (
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"github.com/gin-gonic/gin"
 	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/proto"
 )
 
 type CarDep struct {
@@ -27,11 +31,13 @@ type CarInterface interface {
 	CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateCarRequest) (*grpcmodel.SingleCarReply, error)
 	GetByParam(ctx *gin.Context, cacheControl string, v model.GetCarsByParam) ([]model.Car, model.Pagination, error)
 	GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetCarByParamRequest) (*grpcmodel.GetCarByParamReply, error)
+	GetAvailable(ctx *gin.Context, v model.GetAvailableCarsByParam) ([]model.Car, model.Pagination, error)
+	GetAvailableGRPCProcess(ctx *context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error)
 	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Car, error)
 	GetByIDGRPCProcess(ctx *context.Context, v *grpcmodel.GetCarByIDRequest) (*grpcmodel.SingleCarReply, error)
 	UpdateByID(ctx *gin.Context, id int64, v model.UpdateCar) (model.Car, error)
 	UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.UpdateCarRequest) (*grpcmodel.SingleCarReply, error)
-	DeleteByID(ctx *gin.Context, id int64, vid int64) error
+	DeleteByID(ctx *gin.Context, id int64) error
 	DeleteByIDGRPCProccess(ctx *context.Context, v *grpcmodel.DeleteCarRequest) (*grpcmodel.DeleteCarReply, error)
 }
 
@@ -50,13 +56,17 @@ func (c *CarDep) Create(ctx *gin.Context, v model.CreateCar) (model.Car, error)
 		return result, err
 	}
 
-	data, err := c.car.InsertGRPC(ctx, &grpcmodel.CreateCarRequest{
+	req := &grpcmodel.CreateCarRequest{
 		CarName:   v.CarName,
 		DayRate:   v.DayRate,
 		MonthRate: v.MonthRate,
 		Image:     v.Image,
-		CreatedBy: v.CreatedBy,
-	})
+	}
+	if v.IdempotencyKey != "" {
+		req.IdempotencyKey = &v.IdempotencyKey
+	}
+
+	data, err := c.car.InsertGRPC(ctx, req)
 	if err != nil {
 		return result, err
 	}
@@ -64,26 +74,106 @@ func (c *CarDep) Create(ctx *gin.Context, v model.CreateCar) (model.Car, error)
 	return model.TransformSingleCarReplyToCar(ctx, data, c.log), nil
 }
 
+// CreateGRPCProcess creates the car. When the request carries an idempotency
+// key, a repeated request with the same key returns the car created by the
+// first one instead of inserting again.
 func (c *CarDep) CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateCarRequest) (*grpcmodel.SingleCarReply, error) {
-	var result *grpcmodel.SingleCarReply
+	err := policy.CanManageCar(*ctx)
+	if err != nil {
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
+	if v.GetIdempotencyKey() == "" {
+		car, err := c.create(ctx, v)
+		if err != nil {
+			return &grpcmodel.SingleCarReply{}, err
+		}
+
+		return model.TransformSingleCarReply(car), nil
+	}
+
+	req := proto.Clone(v).(*grpcmodel.CreateCarRequest)
+	req.IdempotencyKey = nil
+	requestHash, err := model.GetRequestHash(req)
+	if err != nil {
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
+	p, _ := model.GetPrincipal(*ctx)
+	key := fmt.Sprintf(model.IdempotencyCarKey, p.ID, v.GetIdempotencyKey())
+	record, reserved, err := c.car.ReserveIdempotencyKey(ctx, key, model.IdempotencyRecord{
+		Status:      model.IdempotencyStatusInProgress,
+		RequestHash: requestHash,
+	})
+	if err != nil {
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
+	if !reserved {
+		err = model.ValidateIdempotencyRecord(record, requestHash)
+		if err != nil {
+			return &grpcmodel.SingleCarReply{}, err
+		}
+
+		var car psqlmodel.Car
+		err = json.Unmarshal(record.Response, &car)
+		if err != nil {
+			return &grpcmodel.SingleCarReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal idempotency response")
+		}
+
+		return model.TransformSingleCarReply(&car), nil
+	}
+
+	car, err := c.create(ctx, v)
+	if err != nil {
+		if errRelease := c.car.ReleaseIdempotencyKey(ctx, key); errRelease != nil {
+			c.log.Warn(*ctx, errRelease)
+		}
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
+	// the car is already stored, so failing to save the response only leaves
+	// the key in progress until it expires.
+	response, err := json.Marshal(car)
+	if err == nil {
+		err = c.car.SaveIdempotencyKey(ctx, key, model.IdempotencyRecord{
+			Status:      model.IdempotencyStatusDone,
+			RequestHash: requestHash,
+			Response:    response,
+		})
+	}
+	if err != nil {
+		c.log.Error(*ctx, err, "error save idempotency key")
+	}
+
+	return model.TransformSingleCarReply(car), nil
+}
+
+func (c *CarDep) create(ctx *context.Context, v *grpcmodel.CreateCarRequest) (*psqlmodel.Car, error) {
+	p, _ := model.GetPrincipal(*ctx)
 	car := &psqlmodel.Car{
 		CarName:   v.CarName,
 		DayRate:   v.DayRate,
 		MonthRate: v.MonthRate,
 		Image:     v.Image,
-		CreatedBy: int(v.CreatedBy),
-		UpdatedBy: int(v.CreatedBy),
+		CreatedBy: int(p.ID),
+		UpdatedBy: int(p.ID),
 	}
 
 	err := c.car.Insert(ctx, car)
 	if err != nil {
-		return result, err
+		return nil, err
 	}
 
-	return model.TransformSingleCarReply(car), nil
+	return car, nil
 }
 
 func (c *CarDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetCarsByParam) ([]model.Car, model.Pagination, error) {
+	err := v.Validate()
+	if err != nil {
+		return []model.Car{}, model.Pagination{}, err
+	}
+
 	param := v.FillGrpcClient()
 	param.CacheControl = cacheControl
 	carSlice, err := c.car.GetCarByParam(ctx, param)
@@ -96,6 +186,11 @@ func (c *CarDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetCa
 
 func (c *CarDep) GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetCarByParamRequest) (*grpcmodel.GetCarByParamReply, error) {
 	param := model.TransformGetCarByParamRequestToCarParam(v)
+	err := param.Validate()
+	if err != nil {
+		return &grpcmodel.GetCarByParamReply{}, err
+	}
+
 	carSlice, pagination, err := c.car.GetByParam(ctx, v.CacheControl, &param)
 	if err != nil {
 		return &grpcmodel.GetCarByParamReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get by param")
@@ -103,6 +198,33 @@ func (c *CarDep) GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetCar
 	return model.TransformCarToGetCarByParamReply(&carSlice, pagination), nil
 }
 
+func (c *CarDep) GetAvailable(ctx *gin.Context, v model.GetAvailableCarsByParam) ([]model.Car, model.Pagination, error) {
+	err := v.Validate()
+	if err != nil {
+		return []model.Car{}, model.Pagination{}, err
+	}
+
+	carSlice, err := c.car.GetAvailableCars(ctx, v.FillGrpcClient())
+	if err != nil {
+		return []model.Car{}, model.Pagination{}, err
+	}
+	cars, pagination := model.TransformCarByParamReplyToCar(ctx, carSlice, c.log)
+	return cars, pagination, nil
+}
+
+func (c *CarDep) GetAvailableGRPCProcess(ctx *context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error) {
+	param, err := model.TransformGetAvailableCarsRequestToCarParam(v)
+	if err != nil {
+		return &grpcmodel.GetCarByParamReply{}, err
+	}
+
+	carSlice, pagination, err := c.car.GetAvailable(ctx, &param)
+	if err != nil {
+		return &grpcmodel.GetCarByParamReply{}, err
+	}
+	return model.TransformCarToGetCarByParamReply(&carSlice, pagination), nil
+}
+
 func (c *CarDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Car, error) {
 	car, err := c.car.GetByIDGRPC(ctx, &grpcmodel.GetCarByIDRequest{
 		Id:           id,
@@ -127,7 +249,7 @@ func (c *CarDep) GetByIDGRPCProcess(ctx *context.Context, v *grpcmodel.GetCarByI
 func (c *CarDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateCar) (model.Car, error) {
 	updateData := v.FillGrpcClient()
 	updateData.Id = id
-	updateData.UpdatedBy = v.UpdatedBy
+	updateData.ExpectedVersion = v.ExpectedVersion
 	car, err := c.car.UpdateGRPC(ctx, updateData)
 	if err != nil {
 		return model.Car{}, err
@@ -137,6 +259,11 @@ func (c *CarDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateCar) (mode
 }
 
 func (c *CarDep) UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.UpdateCarRequest) (*grpcmodel.SingleCarReply, error) {
+	err := policy.CanManageCar(*ctx)
+	if err != nil {
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
 	car, err := c.car.GetSingleByParam(ctx, model.MustRevalidate, &model.GetCarByParam{
 		ID: null.NewInt64(v.Id, true),
 	})
@@ -144,12 +271,18 @@ func (c *CarDep) UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.Update
 		return &grpcmodel.SingleCarReply{}, err
 	}
 
+	err = model.ValidateVersion(v.ExpectedVersion, car.Version)
+	if err != nil {
+		return &grpcmodel.SingleCarReply{}, err
+	}
+
 	if v.CarName == nil && v.DayRate == nil && v.Image == nil && v.MonthRate == nil {
 		return model.TransformSingleCarReply(&car), nil
 	}
 
+	p, _ := model.GetPrincipal(*ctx)
 	model.FillUpdateCar(&car, v)
-	car.UpdatedBy = int(v.UpdatedBy)
+	car.UpdatedBy = int(p.ID)
 
 	err = c.car.Update(ctx, &car)
 	if err != nil {
@@ -159,23 +292,28 @@ func (c *CarDep) UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.Update
 	return model.TransformSingleCarReply(&car), nil
 }
 
-func (c *CarDep) DeleteByID(ctx *gin.Context, id int64, vid int64) error {
+func (c *CarDep) DeleteByID(ctx *gin.Context, id int64) error {
 	_, err := c.car.DeleteGRPC(ctx, &grpcmodel.DeleteCarRequest{
-		Id:        vid,
-		DeletedBy: id,
+		Id: id,
 	})
 
 	return err
 }
 
 func (c *CarDep) DeleteByIDGRPCProccess(ctx *context.Context, v *grpcmodel.DeleteCarRequest) (*grpcmodel.DeleteCarReply, error) {
+	err := policy.CanManageCar(*ctx)
+	if err != nil {
+		return &grpcmodel.DeleteCarReply{}, err
+	}
+
 	car, err := c.car.GetSingleByParam(ctx, model.MustRevalidate, &model.GetCarByParam{
 		ID: null.NewInt64(v.Id, true),
 	})
 	if err != nil {
 		return &grpcmodel.DeleteCarReply{}, err
 	}
-	err = c.car.Delete(ctx, &car, v.DeletedBy, false)
+	p, _ := model.GetPrincipal(*ctx)
+	err = c.car.Delete(ctx, &car, p.ID, false)
 	if err != nil {
 		return &grpcmodel.DeleteCarReply{}, err
 	}
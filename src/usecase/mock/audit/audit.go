@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/audit/audit.go
+
+// Package mock_audit is a generated GoMock package.
+package mock_audit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-ordersvc/src/model"
+	grpcmodel "github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditInterface is a mock of AuditInterface interface.
+type MockAuditInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditInterfaceMockRecorder
+}
+
+// MockAuditInterfaceMockRecorder is the mock recorder for MockAuditInterface.
+type MockAuditInterfaceMockRecorder struct {
+	mock *MockAuditInterface
+}
+
+// NewMockAuditInterface creates a new mock instance.
+func NewMockAuditInterface(ctrl *gomock.Controller) *MockAuditInterface {
+	mock := &MockAuditInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditInterface) EXPECT() *MockAuditInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByParam mocks base method.
+func (m *MockAuditInterface) GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.AuditLog)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockAuditInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockAuditInterface)(nil).GetByParam), ctx, v)
+}
+
+// GetByParamGRPCProcess mocks base method.
+func (m *MockAuditInterface) GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetAuditLogsRequest) (*grpcmodel.GetAuditLogsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParamGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetAuditLogsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByParamGRPCProcess indicates an expected call of GetByParamGRPCProcess.
+func (mr *MockAuditInterfaceMockRecorder) GetByParamGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParamGRPCProcess", reflect.TypeOf((*MockAuditInterface)(nil).GetByParamGRPCProcess), ctx, v)
+}
@@ -68,17 +68,17 @@ func (mr *MockCarInterfaceMockRecorder) CreateGRPCProcess(ctx, v interface{}) *g
 }
 
 // DeleteByID mocks base method.
-func (m *MockCarInterface) DeleteByID(ctx *gin.Context, id, vid int64) error {
+func (m *MockCarInterface) DeleteByID(ctx *gin.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", ctx, id, vid)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockCarInterfaceMockRecorder) DeleteByID(ctx, id, vid interface{}) *gomock.Call {
+func (mr *MockCarInterfaceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockCarInterface)(nil).DeleteByID), ctx, id, vid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockCarInterface)(nil).DeleteByID), ctx, id)
 }
 
 // DeleteByIDGRPCProccess mocks base method.
@@ -96,6 +96,37 @@ func (mr *MockCarInterfaceMockRecorder) DeleteByIDGRPCProccess(ctx, v interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDGRPCProccess", reflect.TypeOf((*MockCarInterface)(nil).DeleteByIDGRPCProccess), ctx, v)
 }
 
+// GetAvailable mocks base method.
+func (m *MockCarInterface) GetAvailable(ctx *gin.Context, v model.GetAvailableCarsByParam) ([]model.Car, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", ctx, v)
+	ret0, _ := ret[0].([]model.Car)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAvailable indicates an expected call of GetAvailable.
+func (mr *MockCarInterfaceMockRecorder) GetAvailable(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockCarInterface)(nil).GetAvailable), ctx, v)
+}
+
+// GetAvailableGRPCProcess mocks base method.
+func (m *MockCarInterface) GetAvailableGRPCProcess(ctx *context.Context, v *grpcmodel.GetAvailableCarsRequest) (*grpcmodel.GetCarByParamReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetCarByParamReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableGRPCProcess indicates an expected call of GetAvailableGRPCProcess.
+func (mr *MockCarInterfaceMockRecorder) GetAvailableGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableGRPCProcess", reflect.TypeOf((*MockCarInterface)(nil).GetAvailableGRPCProcess), ctx, v)
+}
+
 // GetByID mocks base method.
 func (m *MockCarInterface) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Car, error) {
 	m.ctrl.T.Helper()
@@ -37,6 +37,21 @@ func (m *MockOrderInterface) EXPECT() *MockOrderInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CancelByID mocks base method.
+func (m *MockOrderInterface) CancelByID(ctx *gin.Context, id int64, v model.CancelOrder) (model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelByID", ctx, id, v)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelByID indicates an expected call of CancelByID.
+func (mr *MockOrderInterfaceMockRecorder) CancelByID(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelByID", reflect.TypeOf((*MockOrderInterface)(nil).CancelByID), ctx, id, v)
+}
+
 // Create mocks base method.
 func (m *MockOrderInterface) Create(ctx *gin.Context, v model.CreateOrder) (model.Order, error) {
 	m.ctrl.T.Helper()
@@ -68,17 +83,17 @@ func (mr *MockOrderInterfaceMockRecorder) CreateGRPCProcess(ctx, v interface{})
 }
 
 // DeleteByID mocks base method.
-func (m *MockOrderInterface) DeleteByID(ctx *gin.Context, id, vid int64) error {
+func (m *MockOrderInterface) DeleteByID(ctx *gin.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", ctx, id, vid)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockOrderInterfaceMockRecorder) DeleteByID(ctx, id, vid interface{}) *gomock.Call {
+func (mr *MockOrderInterfaceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockOrderInterface)(nil).DeleteByID), ctx, id, vid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockOrderInterface)(nil).DeleteByID), ctx, id)
 }
 
 // DeleteByIDGRPCProccess mocks base method.
@@ -96,6 +111,36 @@ func (mr *MockOrderInterfaceMockRecorder) DeleteByIDGRPCProccess(ctx, v interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDGRPCProccess", reflect.TypeOf((*MockOrderInterface)(nil).DeleteByIDGRPCProccess), ctx, v)
 }
 
+// ExtendByID mocks base method.
+func (m *MockOrderInterface) ExtendByID(ctx *gin.Context, id int64, v model.ExtendOrder) (model.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendByID", ctx, id, v)
+	ret0, _ := ret[0].(model.OrderExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendByID indicates an expected call of ExtendByID.
+func (mr *MockOrderInterfaceMockRecorder) ExtendByID(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendByID", reflect.TypeOf((*MockOrderInterface)(nil).ExtendByID), ctx, id, v)
+}
+
+// ExtendGRPCProcess mocks base method.
+func (m *MockOrderInterface) ExtendGRPCProcess(ctx *context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.ExtendOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendGRPCProcess indicates an expected call of ExtendGRPCProcess.
+func (mr *MockOrderInterfaceMockRecorder) ExtendGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).ExtendGRPCProcess), ctx, v)
+}
+
 // GetByID mocks base method.
 func (m *MockOrderInterface) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Order, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +202,80 @@ func (mr *MockOrderInterfaceMockRecorder) GetByParamGRPCProcess(ctx, v interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParamGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).GetByParamGRPCProcess), ctx, v)
 }
 
+// GetStatusHistory mocks base method.
+func (m *MockOrderInterface) GetStatusHistory(ctx *gin.Context, id int64) ([]model.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, id)
+	ret0, _ := ret[0].([]model.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockOrderInterfaceMockRecorder) GetStatusHistory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockOrderInterface)(nil).GetStatusHistory), ctx, id)
+}
+
+// GetStatusHistoryGRPCProcess mocks base method.
+func (m *MockOrderInterface) GetStatusHistoryGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistoryGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetOrderStatusHistoryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistoryGRPCProcess indicates an expected call of GetStatusHistoryGRPCProcess.
+func (mr *MockOrderInterfaceMockRecorder) GetStatusHistoryGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistoryGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).GetStatusHistoryGRPCProcess), ctx, v)
+}
+
+// ProcessOverdue mocks base method.
+func (m *MockOrderInterface) ProcessOverdue(ctx *context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessOverdue", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessOverdue indicates an expected call of ProcessOverdue.
+func (mr *MockOrderInterfaceMockRecorder) ProcessOverdue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessOverdue", reflect.TypeOf((*MockOrderInterface)(nil).ProcessOverdue), ctx)
+}
+
+// Quote mocks base method.
+func (m *MockOrderInterface) Quote(ctx *gin.Context, v model.CreateOrder) (model.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, v)
+	ret0, _ := ret[0].(model.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockOrderInterfaceMockRecorder) Quote(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockOrderInterface)(nil).Quote), ctx, v)
+}
+
+// QuoteGRPCProcess mocks base method.
+func (m *MockOrderInterface) QuoteGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.QuoteOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteGRPCProcess indicates an expected call of QuoteGRPCProcess.
+func (mr *MockOrderInterfaceMockRecorder) QuoteGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).QuoteGRPCProcess), ctx, v)
+}
+
 // UpdateByID mocks base method.
 func (m *MockOrderInterface) UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrder) (model.Order, error) {
 	m.ctrl.T.Helper()
@@ -186,3 +305,61 @@ func (mr *MockOrderInterfaceMockRecorder) UpdateByIDGRPCProcess(ctx, v interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByIDGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).UpdateByIDGRPCProcess), ctx, v)
 }
+
+// UpdateStatusByID mocks base method.
+func (m *MockOrderInterface) UpdateStatusByID(ctx *gin.Context, id int64, status string) (model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusByID", ctx, id, status)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusByID indicates an expected call of UpdateStatusByID.
+func (mr *MockOrderInterfaceMockRecorder) UpdateStatusByID(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusByID", reflect.TypeOf((*MockOrderInterface)(nil).UpdateStatusByID), ctx, id, status)
+}
+
+// UpdateStatusGRPCProcess mocks base method.
+func (m *MockOrderInterface) UpdateStatusGRPCProcess(ctx *context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusGRPCProcess", ctx, status, v)
+	ret0, _ := ret[0].(*grpcmodel.SingleOrderReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusGRPCProcess indicates an expected call of UpdateStatusGRPCProcess.
+func (mr *MockOrderInterfaceMockRecorder) UpdateStatusGRPCProcess(ctx, status, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).UpdateStatusGRPCProcess), ctx, status, v)
+}
+
+// Watch mocks base method.
+func (m *MockOrderInterface) Watch(ctx context.Context, v model.WatchOrdersByParam, send func(model.OrderEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, v, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockOrderInterfaceMockRecorder) Watch(ctx, v, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockOrderInterface)(nil).Watch), ctx, v, send)
+}
+
+// WatchGRPCProcess mocks base method.
+func (m *MockOrderInterface) WatchGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByParamRequest, send func(*grpcmodel.OrderEventReply) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchGRPCProcess", ctx, v, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchGRPCProcess indicates an expected call of WatchGRPCProcess.
+func (mr *MockOrderInterfaceMockRecorder) WatchGRPCProcess(ctx, v, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchGRPCProcess", reflect.TypeOf((*MockOrderInterface)(nil).WatchGRPCProcess), ctx, v, send)
+}
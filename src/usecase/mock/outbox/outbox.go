@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/outbox/outbox.go
+
+// Package mock_outbox is a generated GoMock package.
+package mock_outbox
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOutboxInterface is a mock of OutboxInterface interface.
+type MockOutboxInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxInterfaceMockRecorder
+}
+
+// MockOutboxInterfaceMockRecorder is the mock recorder for MockOutboxInterface.
+type MockOutboxInterfaceMockRecorder struct {
+	mock *MockOutboxInterface
+}
+
+// NewMockOutboxInterface creates a new mock instance.
+func NewMockOutboxInterface(ctrl *gomock.Controller) *MockOutboxInterface {
+	mock := &MockOutboxInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxInterface) EXPECT() *MockOutboxInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Relay mocks base method.
+func (m *MockOutboxInterface) Relay(ctx *context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockOutboxInterfaceMockRecorder) Relay(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockOutboxInterface)(nil).Relay), ctx)
+}
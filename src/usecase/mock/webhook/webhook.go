@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/webhook/webhook.go
+
+// Package mock_webhook is a generated GoMock package.
+package mock_webhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-ordersvc/src/model"
+	grpcmodel "github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookInterface is a mock of WebhookInterface interface.
+type MockWebhookInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInterfaceMockRecorder
+}
+
+// MockWebhookInterfaceMockRecorder is the mock recorder for MockWebhookInterface.
+type MockWebhookInterfaceMockRecorder struct {
+	mock *MockWebhookInterface
+}
+
+// NewMockWebhookInterface creates a new mock instance.
+func NewMockWebhookInterface(ctrl *gomock.Controller) *MockWebhookInterface {
+	mock := &MockWebhookInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookInterface) EXPECT() *MockWebhookInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookInterface) Create(ctx *gin.Context, v model.CreateWebhook) (model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, v)
+	ret0, _ := ret[0].(model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookInterfaceMockRecorder) Create(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookInterface)(nil).Create), ctx, v)
+}
+
+// CreateGRPCProcess mocks base method.
+func (m *MockWebhookInterface) CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.SingleWebhookReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGRPCProcess indicates an expected call of CreateGRPCProcess.
+func (mr *MockWebhookInterfaceMockRecorder) CreateGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGRPCProcess", reflect.TypeOf((*MockWebhookInterface)(nil).CreateGRPCProcess), ctx, v)
+}
+
+// DeleteByID mocks base method.
+func (m *MockWebhookInterface) DeleteByID(ctx *gin.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockWebhookInterfaceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebhookInterface)(nil).DeleteByID), ctx, id)
+}
+
+// DeleteByIDGRPCProcess mocks base method.
+func (m *MockWebhookInterface) DeleteByIDGRPCProcess(ctx *context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIDGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.DeleteWebhookReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByIDGRPCProcess indicates an expected call of DeleteByIDGRPCProcess.
+func (mr *MockWebhookInterfaceMockRecorder) DeleteByIDGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDGRPCProcess", reflect.TypeOf((*MockWebhookInterface)(nil).DeleteByIDGRPCProcess), ctx, v)
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookInterface) Deliver(ctx *context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookInterfaceMockRecorder) Deliver(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookInterface)(nil).Deliver), ctx)
+}
+
+// Get mocks base method.
+func (m *MockWebhookInterface) Get(ctx *gin.Context) ([]model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookInterfaceMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookInterface)(nil).Get), ctx)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookInterface) GetDeliveries(ctx *gin.Context, v model.GetWebhookDeliveriesByParam) ([]model.WebhookDelivery, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, v)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveries(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveries), ctx, v)
+}
+
+// GetDeliveriesGRPCProcess mocks base method.
+func (m *MockWebhookInterface) GetDeliveriesGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveriesGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetWebhookDeliveriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveriesGRPCProcess indicates an expected call of GetDeliveriesGRPCProcess.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveriesGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveriesGRPCProcess", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveriesGRPCProcess), ctx, v)
+}
+
+// GetGRPCProcess mocks base method.
+func (m *MockWebhookInterface) GetGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.GetWebhooksReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGRPCProcess indicates an expected call of GetGRPCProcess.
+func (mr *MockWebhookInterfaceMockRecorder) GetGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGRPCProcess", reflect.TypeOf((*MockWebhookInterface)(nil).GetGRPCProcess), ctx, v)
+}
+
+// ReplayDelivery mocks base method.
+func (m *MockWebhookInterface) ReplayDelivery(ctx *gin.Context, webhookID, id int64) (model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDelivery", ctx, webhookID, id)
+	ret0, _ := ret[0].(model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDelivery indicates an expected call of ReplayDelivery.
+func (mr *MockWebhookInterfaceMockRecorder) ReplayDelivery(ctx, webhookID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDelivery", reflect.TypeOf((*MockWebhookInterface)(nil).ReplayDelivery), ctx, webhookID, id)
+}
+
+// ReplayDeliveryGRPCProcess mocks base method.
+func (m *MockWebhookInterface) ReplayDeliveryGRPCProcess(ctx *context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeliveryGRPCProcess", ctx, v)
+	ret0, _ := ret[0].(*grpcmodel.WebhookDeliveryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeliveryGRPCProcess indicates an expected call of ReplayDeliveryGRPCProcess.
+func (mr *MockWebhookInterfaceMockRecorder) ReplayDeliveryGRPCProcess(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeliveryGRPCProcess", reflect.TypeOf((*MockWebhookInterface)(nil).ReplayDeliveryGRPCProcess), ctx, v)
+}
@@ -2,48 +2,79 @@ package order
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain/car"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain/order"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/outbox"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-ordersvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-ordersvc/src/policy"
 	"github.com/gin-gonic/gin"
 	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/proto"
 )
 
 type OrderDep struct {
-	log   logger.Logger
-	conf  Conf
-	order order.OrderInterface
-	car   car.CarInterface
+	log    logger.Logger
+	conf   Conf
+	order  order.OrderInterface
+	car    car.CarInterface
+	outbox outbox.OutboxInterface
 }
 
-type Conf struct{}
+type Conf struct {
+	Cancellation CancellationConf `mapstructure:"cancellation"`
+	LateFee      LateFeeConf      `mapstructure:"late_fee"`
+}
+
+type CancellationConf struct {
+	FreeBefore    time.Duration `mapstructure:"free_before"`
+	FeePercentage float64       `mapstructure:"fee_percentage"`
+}
+
+type LateFeeConf struct {
+	Multiplier float64 `mapstructure:"multiplier"`
+}
 
 type OrderInterface interface {
 	Create(ctx *gin.Context, v model.CreateOrder) (model.Order, error)
 	CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.SingleOrderReply, error)
+	Quote(ctx *gin.Context, v model.CreateOrder) (model.Quote, error)
+	QuoteGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error)
 	GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrdersByParam) ([]model.Order, model.Pagination, error)
 	GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByParamRequest) (*grpcmodel.GetOrderByParamReply, error)
+	Watch(ctx context.Context, v model.WatchOrdersByParam, send func(model.OrderEvent) error) error
+	WatchGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByParamRequest, send func(*grpcmodel.OrderEventReply) error) error
 	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Order, error)
 	GetByIDGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByIDRequest) (*grpcmodel.SingleOrderReply, error)
 	UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrder) (model.Order, error)
 	UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.UpdateOrderRequest) (*grpcmodel.SingleOrderReply, error)
-	DeleteByID(ctx *gin.Context, id int64, vid int64) error
+	UpdateStatusByID(ctx *gin.Context, id int64, status string) (model.Order, error)
+	CancelByID(ctx *gin.Context, id int64, v model.CancelOrder) (model.Order, error)
+	ExtendByID(ctx *gin.Context, id int64, v model.ExtendOrder) (model.OrderExtension, error)
+	ExtendGRPCProcess(ctx *context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error)
+	ProcessOverdue(ctx *context.Context) error
+	UpdateStatusGRPCProcess(ctx *context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error)
+	GetStatusHistory(ctx *gin.Context, id int64) ([]model.OrderStatusHistory, error)
+	GetStatusHistoryGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error)
+	DeleteByID(ctx *gin.Context, id int64) error
 	DeleteByIDGRPCProccess(ctx *context.Context, v *grpcmodel.DeleteOrderRequest) (*grpcmodel.DeleteOrderReply, error)
 }
 
-func New(conf Conf, logger *logger.Logger, order order.OrderInterface, car car.CarInterface) OrderInterface {
+func New(conf Conf, logger *logger.Logger, order order.OrderInterface, car car.CarInterface, outbox outbox.OutboxInterface) OrderInterface {
 	return &OrderDep{
-		conf:  conf,
-		log:   *logger,
-		order: order,
-		car:   car,
+		conf:   conf,
+		log:    *logger,
+		order:  order,
+		car:    car,
+		outbox: outbox,
 	}
 }
 
@@ -54,19 +85,7 @@ func (c *OrderDep) Create(ctx *gin.Context, v model.CreateOrder) (model.Order, e
 		return result, err
 	}
 
-	data, err := c.order.InsertGRPC(ctx, &grpcmodel.CreateOrderRequest{
-		CarId:           v.CarID,
-		OrderDate:       v.OrderDate.Format(time.RFC3339),
-		PickupDate:      v.PickupDate.Format(time.RFC3339),
-		DropoffDate:     v.DropoffDate.Format(time.RFC3339),
-		PickupLocation:  v.PickupLocation,
-		PickupLat:       v.PickupLat,
-		PickupLong:      v.PickupLong,
-		DropoffLocation: v.DropoffLocation,
-		DropoffLat:      v.DropoffLat,
-		DropoffLong:     v.DropoffLong,
-		CreatedBy:       v.CreatedBy,
-	})
+	data, err := c.order.InsertGRPC(ctx, v.FillGrpcClient())
 	if err != nil {
 		return result, err
 	}
@@ -74,27 +93,149 @@ func (c *OrderDep) Create(ctx *gin.Context, v model.CreateOrder) (model.Order, e
 	return model.TransformSingleOrderReplyToOrder(ctx, data, c.log), nil
 }
 
+// CreateGRPCProcess creates the order. When the request carries an idempotency
+// key, a repeated request with the same key returns the order created by the
+// first one instead of inserting again.
 func (c *OrderDep) CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.SingleOrderReply, error) {
-	var result *grpcmodel.SingleOrderReply
-	_, err := c.car.GetSingleByParam(ctx, model.MustRevalidate, &model.GetCarByParam{
-		ID: null.Int64From(v.CarId),
+	err := policy.CanCreateOrder(*ctx, v.CreatedBy)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	if v.GetIdempotencyKey() == "" {
+		order, err := c.create(ctx, v)
+		if err != nil {
+			return &grpcmodel.SingleOrderReply{}, err
+		}
+
+		return model.TransformSingleOrderReply(order), nil
+	}
+
+	req := proto.Clone(v).(*grpcmodel.CreateOrderRequest)
+	req.IdempotencyKey = nil
+	requestHash, err := model.GetRequestHash(req)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	key := fmt.Sprintf(model.IdempotencyOrderKey, v.CreatedBy, v.GetIdempotencyKey())
+	record, reserved, err := c.order.ReserveIdempotencyKey(ctx, key, model.IdempotencyRecord{
+		Status:      model.IdempotencyStatusInProgress,
+		RequestHash: requestHash,
 	})
 	if err != nil {
 		return &grpcmodel.SingleOrderReply{}, err
 	}
+
+	if !reserved {
+		err = model.ValidateIdempotencyRecord(record, requestHash)
+		if err != nil {
+			return &grpcmodel.SingleOrderReply{}, err
+		}
+
+		var order psqlmodel.Order
+		err = json.Unmarshal(record.Response, &order)
+		if err != nil {
+			return &grpcmodel.SingleOrderReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error unmarshal idempotency response")
+		}
+
+		return model.TransformSingleOrderReply(&order), nil
+	}
+
+	order, err := c.create(ctx, v)
+	if err != nil {
+		if errRelease := c.order.ReleaseIdempotencyKey(ctx, key); errRelease != nil {
+			c.log.Warn(*ctx, errRelease)
+		}
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	// the order is already stored, so failing to save the response only leaves
+	// the key in progress until it expires.
+	response, err := json.Marshal(order)
+	if err == nil {
+		err = c.order.SaveIdempotencyKey(ctx, key, model.IdempotencyRecord{
+			Status:      model.IdempotencyStatusDone,
+			RequestHash: requestHash,
+			Response:    response,
+		})
+	}
+	if err != nil {
+		c.log.Error(*ctx, err, "error save idempotency key")
+	}
+
+	return model.TransformSingleOrderReply(order), nil
+}
+
+func (c *OrderDep) create(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*psqlmodel.Order, error) {
+	order, err := c.newOrder(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.order.Insert(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (c *OrderDep) Quote(ctx *gin.Context, v model.CreateOrder) (model.Quote, error) {
+	err := v.Validate()
+	if err != nil {
+		return model.Quote{}, err
+	}
+
+	data, err := c.order.QuoteGRPC(ctx, v.FillGrpcClient())
+	if err != nil {
+		return model.Quote{}, err
+	}
+
+	return model.TransformQuoteReplyToQuote(ctx, data, c.log), nil
+}
+
+func (c *OrderDep) QuoteGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*grpcmodel.QuoteOrderReply, error) {
+	order, err := c.newOrder(ctx, v)
+	if err != nil {
+		return &grpcmodel.QuoteOrderReply{}, err
+	}
+
+	err = c.order.CheckAvailability(ctx, order)
+	if err != nil {
+		return &grpcmodel.QuoteOrderReply{}, err
+	}
+
+	return model.TransformQuoteReply(order), nil
+}
+
+// newOrder builds a priced order from the create request without storing it.
+func (c *OrderDep) newOrder(ctx *context.Context, v *grpcmodel.CreateOrderRequest) (*psqlmodel.Order, error) {
+	car, err := c.car.GetSingleByParam(ctx, model.MustRevalidate, &model.GetCarByParam{
+		ID: null.Int64From(v.CarId),
+	})
+	if err != nil {
+		return nil, err
+	}
 	orderDate, err := time.Parse(time.RFC3339, v.OrderDate)
 	if err != nil {
-		return result, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse order date")
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse order date")
 	}
 	pickupDate, err := time.Parse(time.RFC3339, v.PickupDate)
 	if err != nil {
-		return result, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse pickup date")
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse pickup date")
 	}
 	dropoffDate, err := time.Parse(time.RFC3339, v.DropoffDate)
 	if err != nil {
-		return result, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse dropoff date")
+		return nil, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse dropoff date")
+	}
+	if dropoffDate.Before(pickupDate) {
+		return nil, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidDropoffDate, nil, "dropoff date before pickup date")
 	}
 
+	// the order may be created for a customer by staff, who is recorded as
+	// the actor.
+	p, _ := model.GetPrincipal(*ctx)
 	order := &psqlmodel.Order{
 		CarID:           int(v.CarId),
 		OrderDate:       orderDate,
@@ -107,18 +248,19 @@ func (c *OrderDep) CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateOr
 		DropoffLat:      v.DropoffLat,
 		DropoffLong:     v.DropoffLong,
 		CreatedBy:       int(v.CreatedBy),
-		UpdatedBy:       int(v.CreatedBy),
+		UpdatedBy:       int(p.ID),
+		Status:          model.OrderStatusPending,
 	}
+	model.CalculatePrice(pickupDate, dropoffDate, car.DayRate, car.MonthRate).FillEntity(order)
+	return order, nil
+}
 
-	err = c.order.Insert(ctx, order)
+func (c *OrderDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrdersByParam) ([]model.Order, model.Pagination, error) {
+	err := v.Validate()
 	if err != nil {
-		return result, err
+		return []model.Order{}, model.Pagination{}, err
 	}
 
-	return model.TransformSingleOrderReply(order), nil
-}
-
-func (c *OrderDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrdersByParam) ([]model.Order, model.Pagination, error) {
 	param := v.FillGrpcClient()
 	param.CacheControl = cacheControl
 	orderSlice, err := c.order.GetOrderByParam(ctx, param)
@@ -130,12 +272,77 @@ func (c *OrderDep) GetByParam(ctx *gin.Context, cacheControl string, v model.Get
 }
 
 func (c *OrderDep) GetByParamGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByParamRequest) (*grpcmodel.GetOrderByParamReply, error) {
+	owner, err := policy.GetOrderOwner(*ctx)
+	if err != nil {
+		return &grpcmodel.GetOrderByParamReply{}, err
+	}
+
 	param := model.TransformGetOrderByParamRequestToOrderParam(*ctx, v, c.log)
+	err = param.Validate()
+	if err != nil {
+		return &grpcmodel.GetOrderByParamReply{}, err
+	}
+
+	err = model.ValidateFieldMask(&grpcmodel.SingleOrderReply{}, v.Fields)
+	if err != nil {
+		return &grpcmodel.GetOrderByParamReply{}, err
+	}
+
+	param.CreatedBy = owner
 	orderSlice, pagination, err := c.order.GetByParam(ctx, v.CacheControl, &param)
 	if err != nil {
 		return &grpcmodel.GetOrderByParamReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error get by param")
 	}
-	return model.TransformOrderToGetOrderByParamReply(&orderSlice, pagination), nil
+
+	reply := model.TransformOrderToGetOrderByParamReply(&orderSlice, pagination)
+	for _, order := range reply.Data {
+		model.ApplyFieldMask(order, v.Fields)
+	}
+	return reply, nil
+}
+
+// Watch streams order events matching v to send until ctx is done, the
+// stream ends or send fails. It takes the request context rather than the gin
+// context, so a client going away ends the stream.
+func (c *OrderDep) Watch(ctx context.Context, v model.WatchOrdersByParam, send func(model.OrderEvent) error) error {
+	return c.order.WatchGRPC(ctx, v.FillGrpcClient(), func(event *grpcmodel.OrderEventReply) error {
+		return send(model.TransformOrderEventReplyToOrderEvent(ctx, event, c.log))
+	})
+}
+
+// WatchGRPCProcess sends every order event matching the filters of v until ctx
+// is done or send fails, starting after v.LastEventId when it is set. Paging
+// and ordering fields of v are ignored.
+func (c *OrderDep) WatchGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderByParamRequest, send func(*grpcmodel.OrderEventReply) error) error {
+	owner, err := policy.GetOrderOwner(*ctx)
+	if err != nil {
+		return err
+	}
+
+	param := model.TransformGetOrderByParamRequestToOrderParam(*ctx, v, c.log)
+	err = param.GetOrderByParam.Validate()
+	if err != nil {
+		return err
+	}
+
+	param.CreatedBy = owner
+	return c.outbox.Subscribe(*ctx, v.GetLastEventId(), func(event model.OutboxEvent) error {
+		if !model.IsOrderEvent(event) {
+			return nil
+		}
+
+		order, err := model.GetOutboxEventOrder(event)
+		if err != nil {
+			c.log.Warn(*ctx, err)
+			return nil
+		}
+
+		if !param.Match(order) {
+			return nil
+		}
+
+		return send(model.TransformOrderEventReply(event, order))
+	})
 }
 
 func (c *OrderDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Order, error) {
@@ -156,13 +363,18 @@ func (c *OrderDep) GetByIDGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrde
 	if err != nil {
 		return &grpcmodel.SingleOrderReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "data not found")
 	}
+
+	err = policy.CanAccessOrder(*ctx, &order)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
 	return model.TransformSingleOrderReply(&order), nil
 }
 
 func (c *OrderDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrder) (model.Order, error) {
 	updateData := v.FillGrpcClient()
 	updateData.Id = id
-	updateData.UpdatedBy = v.UpdatedBy
+	updateData.ExpectedVersion = v.ExpectedVersion
 	order, err := c.order.UpdateGRPC(ctx, updateData)
 	if err != nil {
 		return model.Order{}, err
@@ -179,14 +391,41 @@ func (c *OrderDep) UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.Upda
 		return &grpcmodel.SingleOrderReply{}, err
 	}
 
+	err = policy.CanChangeOrder(*ctx, &order)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	err = model.ValidateVersion(v.ExpectedVersion, order.Version)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
 	if v.CarId == nil && v.OrderDate == nil && v.PickupDate == nil && v.DropoffDate == nil &&
 		v.PickupLocation == nil && v.PickupLat == nil && v.PickupLong == nil && v.DropoffLocation == nil &&
 		v.DropoffLat == nil && v.DropoffLong == nil {
 		return model.TransformSingleOrderReply(&order), nil
 	}
 
+	err = model.ValidateOrderEditable(order.Status)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
 	model.FillUpdateOrder(*ctx, c.log, &order, v)
-	order.UpdatedBy = int(v.UpdatedBy)
+	if order.DropoffDate.Before(order.PickupDate) {
+		return &grpcmodel.SingleOrderReply{}, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidDropoffDate, nil, "dropoff date before pickup date")
+	}
+
+	car, err := c.car.GetSingleByParam(ctx, model.MustRevalidate, &model.GetCarByParam{
+		ID: null.Int64From(int64(order.CarID)),
+	})
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+	p, _ := model.GetPrincipal(*ctx)
+	model.CalculatePrice(order.PickupDate, order.DropoffDate, car.DayRate, car.MonthRate).FillEntity(&order)
+	order.UpdatedBy = int(p.ID)
 
 	err = c.order.Update(ctx, &order)
 	if err != nil {
@@ -196,23 +435,227 @@ func (c *OrderDep) UpdateByIDGRPCProcess(ctx *context.Context, v *grpcmodel.Upda
 	return model.TransformSingleOrderReply(&order), nil
 }
 
-func (c *OrderDep) DeleteByID(ctx *gin.Context, id int64, vid int64) error {
+func (c *OrderDep) UpdateStatusByID(ctx *gin.Context, id int64, status string) (model.Order, error) {
+	order, err := c.order.UpdateStatusGRPC(ctx, status, &grpcmodel.OrderTransitionRequest{
+		Id: id,
+	})
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	return model.TransformSingleOrderReplyToOrder(ctx, order, c.log), nil
+}
+
+func (c *OrderDep) UpdateStatusGRPCProcess(ctx *context.Context, status string, v *grpcmodel.OrderTransitionRequest) (*grpcmodel.SingleOrderReply, error) {
+	order, err := c.order.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrderByParam{
+		ID: null.NewInt64(v.Id, true),
+	})
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	err = policy.CanUpdateOrderStatus(*ctx, &order, status)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	from := order.Status
+	err = model.ValidateStatusTransition(from, status)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	// the status change is recorded as made by the authenticated principal
+	p, _ := model.GetPrincipal(*ctx)
+	order.Status = status
+	order.UpdatedBy = int(p.ID)
+	switch status {
+	case model.OrderStatusCancelled:
+		c.fillCancellation(&order, v)
+	case model.OrderStatusReturned:
+		c.fillLateFee(&order, time.Now())
+	}
+
+	err = c.order.UpdateStatus(ctx, &order, from)
+	if err != nil {
+		return &grpcmodel.SingleOrderReply{}, err
+	}
+
+	return model.TransformSingleOrderReply(&order), nil
+}
+
+func (c *OrderDep) CancelByID(ctx *gin.Context, id int64, v model.CancelOrder) (model.Order, error) {
+	req := &grpcmodel.OrderTransitionRequest{
+		Id: id,
+	}
+	if v.Reason != "" {
+		req.Reason = &v.Reason
+	}
+
+	order, err := c.order.UpdateStatusGRPC(ctx, model.OrderStatusCancelled, req)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	return model.TransformSingleOrderReplyToOrder(ctx, order, c.log), nil
+}
+
+// fillCancellation records who cancelled the order, why and the fee charged
+// according to the cancellation policy.
+func (c *OrderDep) fillCancellation(order *psqlmodel.Order, v *grpcmodel.OrderTransitionRequest) {
+	now := time.Now()
+	order.CancellationFee = model.CalculateCancellationFee(now, order.PickupDate, order.Subtotal, c.conf.Cancellation.FreeBefore, c.conf.Cancellation.FeePercentage)
+	order.CancellationReason = null.StringFromPtr(v.Reason)
+	order.CancelledBy = null.IntFrom(order.UpdatedBy)
+	order.CancelledAt = null.TimeFrom(now)
+}
+
+func (c *OrderDep) ExtendByID(ctx *gin.Context, id int64, v model.ExtendOrder) (model.OrderExtension, error) {
+	extendData := v.FillGrpcClient()
+	extendData.Id = id
+	extension, err := c.order.ExtendGRPC(ctx, extendData)
+	if err != nil {
+		return model.OrderExtension{}, err
+	}
+
+	return model.TransformExtendOrderReplyToOrderExtension(ctx, extension, c.log), nil
+}
+
+// ExtendGRPCProcess moves the dropoff date of an order forward. The added days
+// are charged with the rates stored on the order, so the customer keeps the
+// rates agreed when ordering.
+func (c *OrderDep) ExtendGRPCProcess(ctx *context.Context, v *grpcmodel.ExtendOrderRequest) (*grpcmodel.ExtendOrderReply, error) {
+	order, err := c.order.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrderByParam{
+		ID: null.NewInt64(v.Id, true),
+	})
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, err
+	}
+
+	err = policy.CanChangeOrder(*ctx, &order)
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, err
+	}
+
+	err = model.ValidateOrderExtendable(order.Status)
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, err
+	}
+
+	dropoffDate, err := time.Parse(time.RFC3339, v.DropoffDate)
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, errormsg.WrapErr(svcerr.OrderSVCBadRequest, err, "error parse dropoff date")
+	}
+	if !dropoffDate.After(order.DropoffDate) {
+		return &grpcmodel.ExtendOrderReply{}, errormsg.WrapErr(svcerr.OrderSVCCodeInvalidExtendDropoff, nil, "dropoff date is not after current dropoff date")
+	}
+
+	from := order.DropoffDate
+	subtotal := order.Subtotal
+	if !order.OriginalDropoffDate.Valid {
+		order.OriginalDropoffDate = null.TimeFrom(from)
+	}
+	p, _ := model.GetPrincipal(*ctx)
+	order.DropoffDate = dropoffDate
+	order.UpdatedBy = int(p.ID)
+	model.CalculatePrice(order.PickupDate, order.DropoffDate, order.DayRate, order.MonthRate).FillEntity(&order)
+
+	err = c.order.Extend(ctx, &order, from)
+	if err != nil {
+		return &grpcmodel.ExtendOrderReply{}, err
+	}
+
+	return model.TransformExtendOrderReply(&order, order.Subtotal-subtotal), nil
+}
+
+// ProcessOverdue flags picked up orders whose dropoff date has passed as
+// overdue and accrues their late fee up to today.
+func (c *OrderDep) ProcessOverdue(ctx *context.Context) error {
+	now := time.Now()
+	orders, err := c.order.GetLate(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		c.fillLateFee(order, now)
+		err = c.order.UpdateLateFee(ctx, order)
+		if err != nil {
+			c.log.Error(*ctx, err, "error update late fee")
+		}
+	}
+
+	return nil
+}
+
+// fillLateFee flags the order as overdue when it is returned late at time now
+// and charges the late days with the day rate stored on the order.
+func (c *OrderDep) fillLateFee(order *psqlmodel.Order, now time.Time) {
+	lateDays, fee := model.CalculateLateFee(now, order.DropoffDate, order.DayRate, c.conf.LateFee.Multiplier)
+	if lateDays == 0 {
+		return
+	}
+
+	if !order.OverdueAt.Valid {
+		order.OverdueAt = null.TimeFrom(now)
+	}
+	order.LateFee = fee
+}
+
+func (c *OrderDep) GetStatusHistory(ctx *gin.Context, id int64) ([]model.OrderStatusHistory, error) {
+	history, err := c.order.GetStatusHistoryGRPC(ctx, &grpcmodel.GetOrderStatusHistoryRequest{
+		Id: id,
+	})
+	if err != nil {
+		return []model.OrderStatusHistory{}, err
+	}
+
+	return model.TransformOrderStatusHistoryReplyToHistory(ctx, history, c.log), nil
+}
+
+func (c *OrderDep) GetStatusHistoryGRPCProcess(ctx *context.Context, v *grpcmodel.GetOrderStatusHistoryRequest) (*grpcmodel.GetOrderStatusHistoryReply, error) {
+	order, err := c.order.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrderByParam{
+		ID: null.NewInt64(v.Id, true),
+	})
+	if err != nil {
+		return &grpcmodel.GetOrderStatusHistoryReply{}, err
+	}
+
+	err = policy.CanAccessOrder(*ctx, &order)
+	if err != nil {
+		return &grpcmodel.GetOrderStatusHistoryReply{}, err
+	}
+
+	history, err := c.order.GetStatusHistory(ctx, v.Id)
+	if err != nil {
+		return &grpcmodel.GetOrderStatusHistoryReply{}, err
+	}
+
+	return model.TransformOrderStatusHistoryReply(&history), nil
+}
+
+func (c *OrderDep) DeleteByID(ctx *gin.Context, id int64) error {
 	_, err := c.order.DeleteGRPC(ctx, &grpcmodel.DeleteOrderRequest{
-		Id:        vid,
-		DeletedBy: id,
+		Id: id,
 	})
 
 	return err
 }
 
 func (c *OrderDep) DeleteByIDGRPCProccess(ctx *context.Context, v *grpcmodel.DeleteOrderRequest) (*grpcmodel.DeleteOrderReply, error) {
+	err := policy.CanDeleteOrder(*ctx)
+	if err != nil {
+		return &grpcmodel.DeleteOrderReply{}, err
+	}
+
 	order, err := c.order.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrderByParam{
 		ID: null.NewInt64(v.Id, true),
 	})
 	if err != nil {
 		return &grpcmodel.DeleteOrderReply{}, err
 	}
-	err = c.order.Delete(ctx, &order, v.DeletedBy, false)
+	p, _ := model.GetPrincipal(*ctx)
+	err = c.order.Delete(ctx, &order, p.ID, false)
 	if err != nil {
 		return &grpcmodel.DeleteOrderReply{}, err
 	}
@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/outbox"
+)
+
+type OutboxDep struct {
+	log    logger.Logger
+	conf   Conf
+	outbox outbox.OutboxInterface
+}
+
+type Conf struct{}
+
+type OutboxInterface interface {
+	Relay(ctx *context.Context) error
+}
+
+func New(conf Conf, logger *logger.Logger, outbox outbox.OutboxInterface) OutboxInterface {
+	return &OutboxDep{
+		conf:   conf,
+		log:    *logger,
+		outbox: outbox,
+	}
+}
+
+// Relay publishes pending outbox events batch by batch until none is due.
+func (o *OutboxDep) Relay(ctx *context.Context) error {
+	for {
+		published, err := o.outbox.Relay(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if published == 0 {
+			return nil
+		}
+	}
+}
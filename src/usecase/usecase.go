@@ -3,8 +3,11 @@ package usecase
 import (
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/achwanyusuf/carrent-ordersvc/src/domain"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/audit"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/car"
 	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/order"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/outbox"
+	"github.com/achwanyusuf/carrent-ordersvc/src/usecase/webhook"
 )
 
 type UsecaseDep struct {
@@ -14,18 +17,27 @@ type UsecaseDep struct {
 }
 
 type Config struct {
-	Car   car.Conf
-	Order order.Conf
+	Car     car.Conf
+	Order   order.Conf
+	Outbox  outbox.Conf
+	Webhook webhook.Conf
+	Audit   audit.Conf
 }
 
 type UsecaseInterface struct {
-	Car   car.CarInterface
-	Order order.OrderInterface
+	Car     car.CarInterface
+	Order   order.OrderInterface
+	Outbox  outbox.OutboxInterface
+	Webhook webhook.WebhookInterface
+	Audit   audit.AuditInterface
 }
 
 func New(u *UsecaseDep) *UsecaseInterface {
 	return &UsecaseInterface{
 		car.New(u.Conf.Car, u.Log, u.Domain.Car),
-		order.New(u.Conf.Order, u.Log, u.Domain.Order, u.Domain.Car),
+		order.New(u.Conf.Order, u.Log, u.Domain.Order, u.Domain.Car, u.Domain.Outbox),
+		outbox.New(u.Conf.Outbox, u.Log, u.Domain.Outbox),
+		webhook.New(u.Conf.Webhook, u.Log, u.Domain.Webhook),
+		audit.New(u.Conf.Audit, u.Log, u.Domain.Audit),
 	}
 }
@@ -0,0 +1,217 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/achwanyusuf/carrent-ordersvc/src/domain/webhook"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/grpcmodel"
+	"github.com/achwanyusuf/carrent-ordersvc/src/model/psqlmodel"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type WebhookDep struct {
+	log     logger.Logger
+	conf    Conf
+	webhook webhook.WebhookInterface
+}
+
+type Conf struct{}
+
+type WebhookInterface interface {
+	Create(ctx *gin.Context, v model.CreateWebhook) (model.Webhook, error)
+	CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error)
+	Get(ctx *gin.Context) ([]model.Webhook, error)
+	GetGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error)
+	DeleteByID(ctx *gin.Context, id int64) error
+	DeleteByIDGRPCProcess(ctx *context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error)
+	GetDeliveries(ctx *gin.Context, v model.GetWebhookDeliveriesByParam) ([]model.WebhookDelivery, model.Pagination, error)
+	GetDeliveriesGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error)
+	ReplayDelivery(ctx *gin.Context, webhookID int64, id int64) (model.WebhookDelivery, error)
+	ReplayDeliveryGRPCProcess(ctx *context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error)
+	Deliver(ctx *context.Context) error
+}
+
+func New(conf Conf, logger *logger.Logger, webhook webhook.WebhookInterface) WebhookInterface {
+	return &WebhookDep{
+		conf:    conf,
+		log:     *logger,
+		webhook: webhook,
+	}
+}
+
+func (w *WebhookDep) Create(ctx *gin.Context, v model.CreateWebhook) (model.Webhook, error) {
+	err := v.Validate()
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	data, err := w.webhook.InsertGRPC(ctx, v.FillGrpcClient())
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	return model.TransformSingleWebhookReplyToWebhook(ctx, data, w.log), nil
+}
+
+// CreateGRPCProcess stores the webhook of the principal with a new signing
+// secret, the secret is only part of this reply.
+func (w *WebhookDep) CreateGRPCProcess(ctx *context.Context, v *grpcmodel.CreateWebhookRequest) (*grpcmodel.SingleWebhookReply, error) {
+	create := model.CreateWebhook{
+		URL:        v.Url,
+		EventTypes: v.EventTypes,
+	}
+	err := create.Validate()
+	if err != nil {
+		return &grpcmodel.SingleWebhookReply{}, err
+	}
+
+	secret, err := model.GenerateWebhookSecret()
+	if err != nil {
+		return &grpcmodel.SingleWebhookReply{}, err
+	}
+
+	p, _ := model.GetPrincipal(*ctx)
+	webhook := &psqlmodel.Webhook{
+		URL:          v.Url,
+		Secret:       secret,
+		EventTypes:   types.StringArray(v.EventTypes),
+		CreatedBy:    int(p.ID),
+		CreatedScope: p.Scope,
+		UpdatedBy:    int(p.ID),
+	}
+	err = w.webhook.Insert(ctx, webhook)
+	if err != nil {
+		return &grpcmodel.SingleWebhookReply{}, err
+	}
+
+	reply := model.TransformSingleWebhookReply(webhook)
+	reply.Secret = &webhook.Secret
+	return reply, nil
+}
+
+func (w *WebhookDep) Get(ctx *gin.Context) ([]model.Webhook, error) {
+	webhooks, err := w.webhook.GetByOwnerGRPC(ctx, &grpcmodel.GetWebhooksRequest{})
+	if err != nil {
+		return []model.Webhook{}, err
+	}
+
+	return model.TransformWebhooksReplyToWebhooks(ctx, webhooks, w.log), nil
+}
+
+// GetGRPCProcess returns the webhooks of the principal.
+func (w *WebhookDep) GetGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhooksRequest) (*grpcmodel.GetWebhooksReply, error) {
+	p, _ := model.GetPrincipal(*ctx)
+	webhooks, err := w.webhook.GetByOwner(ctx, p.ID)
+	if err != nil {
+		return &grpcmodel.GetWebhooksReply{}, err
+	}
+
+	return model.TransformWebhooksReply(&webhooks), nil
+}
+
+func (w *WebhookDep) DeleteByID(ctx *gin.Context, id int64) error {
+	_, err := w.webhook.DeleteGRPC(ctx, &grpcmodel.DeleteWebhookRequest{
+		Id: id,
+	})
+
+	return err
+}
+
+// DeleteByIDGRPCProcess deletes a webhook of the principal.
+func (w *WebhookDep) DeleteByIDGRPCProcess(ctx *context.Context, v *grpcmodel.DeleteWebhookRequest) (*grpcmodel.DeleteWebhookReply, error) {
+	p, _ := model.GetPrincipal(*ctx)
+	webhook, err := w.webhook.GetSingleByOwner(ctx, v.Id, p.ID)
+	if err != nil {
+		return &grpcmodel.DeleteWebhookReply{}, err
+	}
+
+	err = w.webhook.Delete(ctx, &webhook, p.ID)
+	if err != nil {
+		return &grpcmodel.DeleteWebhookReply{}, err
+	}
+
+	return &grpcmodel.DeleteWebhookReply{
+		Id: v.Id,
+	}, nil
+}
+
+func (w *WebhookDep) GetDeliveries(ctx *gin.Context, v model.GetWebhookDeliveriesByParam) ([]model.WebhookDelivery, model.Pagination, error) {
+	deliveries, err := w.webhook.GetDeliveriesGRPC(ctx, v.FillGrpcClient())
+	if err != nil {
+		return []model.WebhookDelivery{}, model.Pagination{}, err
+	}
+
+	result, pagination := model.TransformWebhookDeliveriesReplyToDeliveries(ctx, deliveries, w.log)
+	return result, pagination, nil
+}
+
+// GetDeliveriesGRPCProcess returns the delivery log of a webhook of the
+// principal, newest first.
+func (w *WebhookDep) GetDeliveriesGRPCProcess(ctx *context.Context, v *grpcmodel.GetWebhookDeliveriesRequest) (*grpcmodel.GetWebhookDeliveriesReply, error) {
+	p, _ := model.GetPrincipal(*ctx)
+	_, err := w.webhook.GetSingleByOwner(ctx, v.WebhookId, p.ID)
+	if err != nil {
+		return &grpcmodel.GetWebhookDeliveriesReply{}, err
+	}
+
+	param := model.TransformGetWebhookDeliveriesRequestToParam(v)
+	deliveries, pagination, err := w.webhook.GetDeliveries(ctx, &param)
+	if err != nil {
+		return &grpcmodel.GetWebhookDeliveriesReply{}, err
+	}
+
+	return model.TransformWebhookDeliveriesReply(&deliveries, pagination), nil
+}
+
+func (w *WebhookDep) ReplayDelivery(ctx *gin.Context, webhookID int64, id int64) (model.WebhookDelivery, error) {
+	delivery, err := w.webhook.ReplayGRPC(ctx, &grpcmodel.ReplayWebhookDeliveryRequest{
+		Id:        id,
+		WebhookId: webhookID,
+	})
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+
+	return model.TransformWebhookDeliveryReplyToDelivery(ctx, delivery, w.log), nil
+}
+
+// ReplayDeliveryGRPCProcess schedules a delivery of a webhook of the principal
+// to be sent again right away.
+func (w *WebhookDep) ReplayDeliveryGRPCProcess(ctx *context.Context, v *grpcmodel.ReplayWebhookDeliveryRequest) (*grpcmodel.WebhookDeliveryReply, error) {
+	p, _ := model.GetPrincipal(*ctx)
+	_, err := w.webhook.GetSingleByOwner(ctx, v.WebhookId, p.ID)
+	if err != nil {
+		return &grpcmodel.WebhookDeliveryReply{}, err
+	}
+
+	delivery, err := w.webhook.GetSingleDelivery(ctx, v.WebhookId, v.Id)
+	if err != nil {
+		return &grpcmodel.WebhookDeliveryReply{}, err
+	}
+
+	err = w.webhook.Replay(ctx, &delivery, time.Now())
+	if err != nil {
+		return &grpcmodel.WebhookDeliveryReply{}, err
+	}
+
+	return model.TransformWebhookDeliveryReply(&delivery), nil
+}
+
+// Deliver sends due webhook deliveries batch by batch until a batch has none
+// accepted.
+func (w *WebhookDep) Deliver(ctx *context.Context) error {
+	for {
+		delivered, err := w.webhook.Deliver(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if delivered == 0 {
+			return nil
+		}
+	}
+}